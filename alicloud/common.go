@@ -20,9 +20,9 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/denverdino/aliyungo/cs"
 
@@ -33,8 +33,9 @@ import (
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/aliyun/aliyun-tablestore-go-sdk/tablestore"
 	"github.com/aliyun/fc-go-sdk"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"gopkg.in/yaml.v2"
 
@@ -923,9 +924,13 @@ func addDebug(action, content interface{}, requestInfo ...interface{}) {
 
 			if len(requestInfo) == 1 {
 				if v, ok := requestInfo[0].(map[string]interface{}); ok {
+					v = connectivity.RedactFields(v)
 					if res, err := json.Marshal(&v); err == nil {
 						requestContent = string(res)
 					}
+					if m, ok := content.(map[string]interface{}); ok {
+						content = connectivity.RedactFields(m)
+					}
 					if res, err := json.Marshal(&content); err == nil {
 						content = string(res)
 					}
@@ -1336,7 +1341,7 @@ func mapSort(target map[string]string) []string {
 
 func newInstanceDiff(resourceName string, attributes, attributesDiff map[string]interface{}, state *terraform.InstanceState) (*terraform.InstanceDiff, error) {
 
-	p := Provider().(*schema.Provider).ResourcesMap
+	p := Provider().ResourcesMap
 	dOld, _ := schema.InternalMap(p[resourceName].Schema).Data(state, nil)
 	dNew, _ := schema.InternalMap(p[resourceName].Schema).Data(state, nil)
 	for key, value := range attributes {
@@ -1382,7 +1387,7 @@ func newInstanceDiff(resourceName string, attributes, attributesDiff map[string]
 				}
 			}
 		}
-		diff.SetAttribute(key, &terraform.ResourceAttrDiff{Old: oldValue, New: newValue})
+		diff.Attributes[key] = &terraform.ResourceAttrDiff{Old: oldValue, New: newValue}
 
 		if objectKey != "" {
 			for removeKey, removeValue := range dOld.State().Attributes {
@@ -1390,9 +1395,9 @@ func newInstanceDiff(resourceName string, attributes, attributesDiff map[string]
 					if _, ok := dNew.State().Attributes[removeKey]; !ok {
 						// If the attribue has complex elements, there should remove the key, not setting it to empty
 						if len(strings.Split(removeKey, ".")) > 2 {
-							diff.DelAttribute(removeKey)
+							delete(diff.Attributes, removeKey)
 						} else {
-							diff.SetAttribute(removeKey, &terraform.ResourceAttrDiff{Old: removeValue, New: ""})
+							diff.Attributes[removeKey] = &terraform.ResourceAttrDiff{Old: removeValue, New: ""}
 						}
 					}
 				}
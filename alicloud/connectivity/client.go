@@ -12,7 +12,7 @@ import (
 	"sync"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 
@@ -87,6 +87,13 @@ type AliyunClient struct {
 	SecretKey                    string
 	SecurityToken                string
 	OtsInstanceName              string
+	DefaultTags                  map[string]interface{}
+	IgnoreTags                   *IgnoreTags
+	rateLimiters                 sync.Map
+	rpcClients                   rpcClientPool
+	parent                       *AliyunClient
+	regionalClients              sync.Map
+	regionalClientsMutex         sync.Mutex
 	accountIdMutex               sync.RWMutex
 	config                       *Config
 	teaSdkConfig                 rpc.Config
@@ -227,6 +234,43 @@ func (c *Config) Client() (*AliyunClient, error) {
 			return nil, err
 		}
 	}
+	if c.RecordMode != "" {
+		recorder, err := GetRecorder(c.RecordMode, c.CassetteFile)
+		if err != nil {
+			return nil, err
+		}
+		c.recorder = recorder
+		// The recorder sends the requests to Alibaba Cloud with https.
+		c.Protocol = "HTTP"
+	}
+	if c.TraceFile != "" {
+		tracer, err := GetTracer(c.TraceFile)
+		if err != nil {
+			return nil, err
+		}
+		c.tracer = tracer
+	}
+	if c.EndpointCacheFile != "" {
+		endpointCache, err := GetEndpointCache(c.EndpointCacheFile, c.EndpointCacheTTL)
+		if err != nil {
+			return nil, err
+		}
+		c.endpointCache = endpointCache
+	}
+	client, err := c.newClient()
+	if err != nil {
+		return nil, err
+	}
+	if c.AccountType == "" {
+		c.AccountType = client.GetAccountType()
+		client.config = c
+	}
+	log.Printf("[INFO] caller identity's account type is %s.", client.config.AccountType)
+	return client, nil
+}
+
+// newClient returns the client built on the config, whose product clients are initialized when they are used.
+func (c *Config) newClient() (*AliyunClient, error) {
 	teaSdkConfig, err := c.getTeaDslSdkConfig(true)
 	if err != nil {
 		return nil, err
@@ -256,17 +300,14 @@ func (c *Config) Client() (*AliyunClient, error) {
 		SecretKey:                    c.SecretKey,
 		SecurityToken:                c.SecurityToken,
 		OtsInstanceName:              c.OtsInstanceName,
+		DefaultTags:                  c.DefaultTags,
+		IgnoreTags:                   c.IgnoreTags,
 		accountId:                    c.AccountId,
 		tablestoreconnByInstanceName: make(map[string]*tablestore.TableStoreClient),
 		otsTunnelConnByInstanceName:  make(map[string]otsTunnel.TunnelClient),
 		csprojectconnByKey:           make(map[string]*cs.ProjectClient),
 		skipRegionValidation:         c.SkipRegionValidation,
 	}
-	if c.AccountType == "" {
-		c.AccountType = client.GetAccountType()
-		client.config = c
-	}
-	log.Printf("[INFO] caller identity's account type is %s.", client.config.AccountType)
 	return client, nil
 }
 
@@ -328,7 +369,7 @@ func (client *AliyunClient) WithOfficalCSClient(do func(*officalCS.Client) (inte
 	if client.officalCSConn == nil {
 		endpoint := client.config.CsEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, CONTAINCode)
+			endpoint = client.loadLegacyEndpoint(CONTAINCode)
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(CONTAINCode), endpoint)
@@ -357,7 +398,7 @@ func (client *AliyunClient) WithPolarDBClient(do func(*polardb.Client) (interfac
 	if client.polarDBconn == nil {
 		endpoint := client.config.PolarDBEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, POLARDBCode)
+			endpoint = client.loadLegacyEndpoint(POLARDBCode)
 			if endpoint == "" {
 				endpoint = fmt.Sprintf("%s.polardb.aliyuncs.com", client.config.RegionId)
 			}
@@ -388,7 +429,7 @@ func (client *AliyunClient) WithSlbClient(do func(*slb.Client) (interface{}, err
 	if client.slbconn == nil {
 		endpoint := client.config.SlbEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, SLBCode)
+			endpoint = client.loadLegacyEndpoint(SLBCode)
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(SLBCode), endpoint)
@@ -417,7 +458,7 @@ func (client *AliyunClient) WithVpcClient(do func(*vpc.Client) (interface{}, err
 	if client.vpcconn == nil {
 		endpoint := client.config.VpcEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, VPCCode)
+			endpoint = client.loadLegacyEndpoint(VPCCode)
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(VPCCode), endpoint)
@@ -443,6 +484,7 @@ func (client *AliyunClient) WithVpcClient(do func(*vpc.Client) (interface{}, err
 
 func (client *AliyunClient) NewEcsClient() (*rpc.Client, error) {
 	productCode := "ecs"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -472,6 +514,7 @@ func (client *AliyunClient) NewEcsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewVpcClient() (*rpc.Client, error) {
 	productCode := "vpc"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -498,6 +541,7 @@ func (client *AliyunClient) NewVpcClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewRdsClient() (*rpc.Client, error) {
 	productCode := "rds"
+	client.waitRateLimit(productCode)
 	endpoint := "rds.aliyuncs.com"
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -525,6 +569,7 @@ func (client *AliyunClient) NewRdsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewPolarDBClient() (*rpc.Client, error) {
 	productCode := "polardb"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -554,7 +599,7 @@ func (client *AliyunClient) WithCenClient(do func(*cbn.Client) (interface{}, err
 	if client.cenconn == nil {
 		endpoint := client.config.CenEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, CbnCode)
+			endpoint = client.loadLegacyEndpoint(CbnCode)
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(CbnCode), endpoint)
@@ -588,7 +633,7 @@ func (client *AliyunClient) WithEssClient(do func(*ess.Client) (interface{}, err
 	if client.essconn == nil {
 		endpoint := client.config.EssEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, ESSCode)
+			endpoint = client.loadLegacyEndpoint(ESSCode)
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(ESSCode), endpoint)
@@ -621,7 +666,7 @@ func (client *AliyunClient) WithOssClient(do func(*oss.Client) (interface{}, err
 		schma := strings.ToLower(client.config.Protocol)
 		endpoint := client.config.OssEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, OSSCode)
+			endpoint = client.loadLegacyEndpoint(OSSCode)
 		}
 		if endpoint == "" {
 			endpointItem, err := client.describeEndpointForService(strings.ToLower(string(OSSCode)))
@@ -694,7 +739,7 @@ func (client *AliyunClient) WithDnsClient(do func(*alidns.Client) (interface{},
 	if client.dnsconn == nil {
 		endpoint := client.config.DnsEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, DNSCode)
+			endpoint = client.loadLegacyEndpoint(DNSCode)
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(DNSCode), endpoint)
@@ -723,7 +768,7 @@ func (client *AliyunClient) WithRamClient(do func(*ram.Client) (interface{}, err
 	if client.ramconn == nil {
 		endpoint := client.config.RamEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, RAMCode)
+			endpoint = client.loadLegacyEndpoint(RAMCode)
 		}
 		if strings.HasPrefix(endpoint, "http") {
 			endpoint = fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "http://"))
@@ -766,7 +811,7 @@ func (client *AliyunClient) WithCsClient(do func(*cs.Client) (interface{}, error
 		csconn.SetUserAgent(client.getUserAgent())
 		endpoint := client.config.CsEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, CONTAINCode)
+			endpoint = client.loadLegacyEndpoint(CONTAINCode)
 		}
 		if endpoint != "" {
 			if !strings.HasPrefix(endpoint, "http") {
@@ -783,6 +828,7 @@ func (client *AliyunClient) WithCsClient(do func(*cs.Client) (interface{}, error
 }
 
 func (client *AliyunClient) NewRoaCsClient() (*roaCS.Client, error) {
+	client.waitRateLimit("cs")
 	endpoint := client.config.CsEndpoint
 	if endpoint == "" {
 		endpoint = OpenAckService
@@ -816,7 +862,7 @@ func (client *AliyunClient) WithCrClient(do func(*cr.Client) (interface{}, error
 	if client.crconn == nil {
 		endpoint := client.config.CrEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, CRCode)
+			endpoint = client.loadLegacyEndpoint(CRCode)
 			if endpoint == "" {
 				endpoint = fmt.Sprintf("cr.%s.aliyuncs.com", client.config.RegionId)
 			}
@@ -847,7 +893,7 @@ func (client *AliyunClient) WithCrEEClient(do func(*cr_ee.Client) (interface{},
 	if client.creeconn == nil {
 		endpoint := client.config.CrEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, CRCode)
+			endpoint = client.loadLegacyEndpoint(CRCode)
 			if endpoint == "" {
 				endpoint = fmt.Sprintf("cr.%s.aliyuncs.com", client.config.RegionId)
 			}
@@ -885,7 +931,7 @@ func (client *AliyunClient) WithCdnClient(do func(*cdn.CdnClient) (interface{},
 		cdnconn.SetSecurityToken(client.config.SecurityToken)
 		endpoint := client.config.CdnEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, CDNCode)
+			endpoint = client.loadLegacyEndpoint(CDNCode)
 		}
 		if endpoint != "" && !strings.HasPrefix(endpoint, "http") {
 			cdnconn.SetEndpoint(fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "://")))
@@ -900,7 +946,7 @@ func (client *AliyunClient) WithCdnClient_new(do func(*cdn_new.Client) (interfac
 	if client.cdnconn_new == nil {
 		endpoint := client.config.CdnEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, CDNCode)
+			endpoint = client.loadLegacyEndpoint(CDNCode)
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(CDNCode), endpoint)
@@ -929,7 +975,7 @@ func (client *AliyunClient) WithOtsClient(do func(*ots.Client) (interface{}, err
 	if client.otsconn == nil {
 		endpoint := client.config.OtsEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, OTSCode)
+			endpoint = client.loadLegacyEndpoint(OTSCode)
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(OTSCode), endpoint)
@@ -955,6 +1001,7 @@ func (client *AliyunClient) WithOtsClient(do func(*ots.Client) (interface{}, err
 
 // NewOtsRoaClient rpc client for common sdk
 func (client *AliyunClient) NewOtsRoaClient(productCode string) (*roa.Client, error) {
+	client.waitRateLimit(productCode)
 	// first, load endpoint by user setting
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		// second,  load endpoint by serverside rule
@@ -1038,7 +1085,7 @@ func (client *AliyunClient) WithLogPopClient(do func(*slsPop.Client) (interface{
 		logpopconn.AppendUserAgent(TerraformTraceId, client.config.TerraformTraceId)
 		endpoint := client.config.LogEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, LOGCode)
+			endpoint = client.loadLegacyEndpoint(LOGCode)
 			if endpoint == "" {
 				endpoint = fmt.Sprintf("%s.log.aliyuncs.com", client.config.RegionId)
 			}
@@ -1060,7 +1107,7 @@ func (client *AliyunClient) WithLogClient(do func(*sls.Client) (interface{}, err
 	if client.logconn == nil {
 		endpoint := client.config.LogEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, LOGCode)
+			endpoint = client.loadLegacyEndpoint(LOGCode)
 			if endpoint == "" {
 				endpoint = fmt.Sprintf("%s.log.aliyuncs.com", client.config.RegionId)
 			}
@@ -1085,7 +1132,7 @@ func (client *AliyunClient) WithDrdsClient(do func(*drds.Client) (interface{}, e
 	if client.drdsconn == nil {
 		endpoint := client.config.DrdsEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, DRDSCode)
+			endpoint = client.loadLegacyEndpoint(DRDSCode)
 			if endpoint == "" {
 				endpoint = fmt.Sprintf("%s.drds.aliyuncs.com", client.config.RegionId)
 			}
@@ -1160,7 +1207,7 @@ func (client *AliyunClient) WithGpdbClient(do func(*gpdb.Client) (interface{}, e
 	if client.gpdbconn == nil {
 		endpoint := client.config.GpdbEnpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, GPDBCode)
+			endpoint = client.loadLegacyEndpoint(GPDBCode)
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(GPDBCode), endpoint)
@@ -1185,6 +1232,7 @@ func (client *AliyunClient) WithGpdbClient(do func(*gpdb.Client) (interface{}, e
 
 func (client *AliyunClient) NewGpdbClient() (*rpc.Client, error) {
 	productCode := "gpdb"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -1217,7 +1265,7 @@ func (client *AliyunClient) WithFcClient(do func(*fc.Client) (interface{}, error
 	if client.fcconn == nil {
 		endpoint := client.config.FcEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, FCCode)
+			endpoint = client.loadLegacyEndpoint(FCCode)
 			if endpoint == "" {
 				endpoint = fmt.Sprintf("%s.fc.aliyuncs.com", client.config.RegionId)
 			}
@@ -1341,7 +1389,7 @@ func (client *AliyunClient) WithMnsClient(do func(*ali_mns.MNSClient) (interface
 	if client.mnsconn == nil {
 		endpoint := client.config.MnsEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, MNSCode)
+			endpoint = client.loadLegacyEndpoint(MNSCode)
 			if endpoint == "" {
 				endpoint = fmt.Sprintf("%s.aliyuncs.com", client.config.RegionId)
 			}
@@ -1378,7 +1426,7 @@ func (client *AliyunClient) WithElasticsearchClient(do func(*elasticsearch.Clien
 	if client.elasticsearchconn == nil {
 		endpoint := client.config.ElasticsearchEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, ELASTICSEARCHCode)
+			endpoint = client.loadLegacyEndpoint(ELASTICSEARCHCode)
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(ELASTICSEARCHCode), endpoint)
@@ -1403,6 +1451,7 @@ func (client *AliyunClient) WithElasticsearchClient(do func(*elasticsearch.Clien
 
 func (client *AliyunClient) NewElasticsearchClient() (*roa.Client, error) {
 	productCode := "elasticsearch"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -1534,6 +1583,7 @@ func (client *AliyunClient) WithCsProjectClient(clusterId, endpoint string, clus
 func (client *AliyunClient) NewCommonRequest(product, serviceCode, schema string, apiVersion ApiVersion) (*requests.CommonRequest, error) {
 	endpoint := ""
 	product = strings.ToLower(product)
+	client.waitRateLimit(product)
 	if _, exist := client.config.Endpoints.Load(product); !exist {
 		if err := client.loadEndpoint(product); err != nil {
 			return nil, err
@@ -1657,6 +1707,9 @@ func (client *AliyunClient) getTransport() *http.Transport {
 	}
 	transport := &http.Transport{}
 	transport.TLSHandshakeTimeout = time.Duration(handshakeTimeout) * time.Second
+	if client.config.recorder != nil {
+		transport.Proxy = http.ProxyURL(client.config.recorder.ProxyURL)
+	}
 
 	return transport
 }
@@ -1705,7 +1758,7 @@ func (client *AliyunClient) GetCallerIdentity() (*sts.GetCallerIdentityResponse,
 
 	endpoint := client.config.StsEndpoint
 	if endpoint == "" {
-		endpoint = loadEndpoint(client.config.RegionId, STSCode)
+		endpoint = client.loadLegacyEndpoint(STSCode)
 	}
 	if endpoint != "" {
 		endpoints.AddEndpointMapping(client.config.RegionId, string(STSCode), endpoint)
@@ -1739,7 +1792,7 @@ func (client *AliyunClient) WithDdoscooClient(do func(*ddoscoo.Client) (interfac
 	if client.ddoscooconn == nil {
 		endpoint := client.config.DdoscooEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, DDOSCOOCode)
+			endpoint = client.loadLegacyEndpoint(DDOSCOOCode)
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(DDOSCOOCode), endpoint)
@@ -1773,7 +1826,7 @@ func (client *AliyunClient) WithDdosbgpClient(do func(*ddosbgp.Client) (interfac
 	if client.ddosbgpconn == nil {
 		endpoint := client.config.DdosbgpEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, DDOSBGPCode)
+			endpoint = client.loadLegacyEndpoint(DDOSBGPCode)
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(DDOSBGPCode), endpoint)
@@ -1802,7 +1855,7 @@ func (client *AliyunClient) WithBssopenapiClient(do func(*bssopenapi.Client) (in
 	if client.bssopenapiconn == nil {
 		endpoint := client.config.BssOpenApiEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, BSSOPENAPICode)
+			endpoint = client.loadLegacyEndpoint(BSSOPENAPICode)
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(BSSOPENAPICode), endpoint)
@@ -1841,7 +1894,7 @@ func (client *AliyunClient) WithAlikafkaClient(do func(*alikafka.Client) (interf
 			endpoint = v.(string)
 		}
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, ALIKAFKACode)
+			endpoint = client.loadLegacyEndpoint(ALIKAFKACode)
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(ALIKAFKACode), endpoint)
@@ -1868,7 +1921,7 @@ func (client *AliyunClient) WithEmrClient(do func(*emr.Client) (interface{}, err
 	if client.emrconn == nil {
 		endpoint := client.config.EmrEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, EMRCode)
+			endpoint = client.loadLegacyEndpoint(EMRCode)
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(EMRCode), endpoint)
@@ -1896,7 +1949,7 @@ func (client *AliyunClient) WithSagClient(do func(*smartag.Client) (interface{},
 	if client.sagconn == nil {
 		endpoint := client.config.SagEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, SAGCode)
+			endpoint = client.loadLegacyEndpoint(SAGCode)
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(SAGCode), endpoint)
@@ -1998,7 +2051,7 @@ func (client *AliyunClient) WithHbaseClient(do func(*hbase.Client) (interface{},
 	if client.hbaseconn == nil {
 		endpoint := client.config.HBaseEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, HBASECode)
+			endpoint = client.loadLegacyEndpoint(HBASECode)
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(HBASECode), endpoint)
@@ -2026,7 +2079,7 @@ func (client *AliyunClient) WithAdbClient(do func(*adb.Client) (interface{}, err
 	if client.adbconn == nil {
 		endpoint := client.config.AdbEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, ADBCode)
+			endpoint = client.loadLegacyEndpoint(ADBCode)
 			if endpoint == "" {
 				endpoint = fmt.Sprintf("%s.adb.aliyuncs.com", client.config.RegionId)
 			}
@@ -2053,6 +2106,7 @@ func (client *AliyunClient) WithAdbClient(do func(*adb.Client) (interface{}, err
 
 func (client *AliyunClient) NewCbnClient() (*rpc.Client, error) {
 	productCode := "cbn"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2078,7 +2132,7 @@ func (client *AliyunClient) WithCbnClient(do func(*cbn.Client) (interface{}, err
 	if client.cbnConn == nil {
 		endpoint := client.config.CbnEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, CbnCode)
+			endpoint = client.loadLegacyEndpoint(CbnCode)
 			// compatible with cen
 			if endpoint == "" {
 				endpoint = "cbn.aliyuncs.com"
@@ -2113,7 +2167,7 @@ func (client *AliyunClient) WithEdasClient(do func(*edas.Client) (interface{}, e
 	if client.edasconn == nil {
 		endpoint := client.config.edasEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, EDASCode)
+			endpoint = client.loadLegacyEndpoint(EDASCode)
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.config.RegionId, string(EDASCode), endpoint)
@@ -2145,7 +2199,7 @@ func (client *AliyunClient) WithAlidnsClient(do func(*alidns.Client) (interface{
 	if client.alidnsConn == nil {
 		endpoint := client.config.AlidnsEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, AlidnsCode)
+			endpoint = client.loadLegacyEndpoint(AlidnsCode)
 		}
 		if strings.HasPrefix(endpoint, "http") {
 			endpoint = fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "http://"))
@@ -2175,7 +2229,7 @@ func (client *AliyunClient) WithCassandraClient(do func(*cassandra.Client) (inte
 	if client.cassandraConn == nil {
 		endpoint := client.config.CassandraEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, CassandraCode)
+			endpoint = client.loadLegacyEndpoint(CassandraCode)
 			endpoints.AddEndpointMapping(client.config.RegionId, string(CassandraCode), endpoint)
 		}
 		cassandraConn, err := cassandra.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
@@ -2199,7 +2253,7 @@ func (client *AliyunClient) WithEciClient(do func(*eci.Client) (interface{}, err
 	if client.eciConn == nil {
 		endpoint := client.config.EciEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, EciCode)
+			endpoint = client.loadLegacyEndpoint(EciCode)
 		}
 		if strings.HasPrefix(endpoint, "http") {
 			endpoint = fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "http://"))
@@ -2229,7 +2283,7 @@ func (client *AliyunClient) WithDcdnClient(do func(*dcdn.Client) (interface{}, e
 	if client.dcdnConn == nil {
 		endpoint := client.config.DcdnEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.config.RegionId, DcdnCode)
+			endpoint = client.loadLegacyEndpoint(DcdnCode)
 		}
 		if strings.HasPrefix(endpoint, "http") {
 			endpoint = fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "http://"))
@@ -2292,6 +2346,7 @@ func (client *AliyunClient) WithRKvstoreClient(do func(*r_kvstore.Client) (inter
 
 func (client *AliyunClient) NewOnsClient() (*rpc.Client, error) {
 	productCode := "ons"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2315,6 +2370,7 @@ func (client *AliyunClient) NewOnsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewCmsClient() (*rpc.Client, error) {
 	productCode := "cms"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2338,6 +2394,7 @@ func (client *AliyunClient) NewCmsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewConfigClient() (*rpc.Client, error) {
 	productCode := "config"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2363,6 +2420,7 @@ func (client *AliyunClient) NewConfigClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewWafClient() (*rpc.Client, error) {
 	productCode := "waf_openapi"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2386,6 +2444,7 @@ func (client *AliyunClient) NewWafClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewBssopenapiClient() (*rpc.Client, error) {
 	productCode := "bssopenapi"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	// bss endpoint depends on the account type.
 	// Domestic account is business.aliyuncs.com and International account is business.ap-southeast-1.aliyuncs.com
@@ -2413,6 +2472,7 @@ func (client *AliyunClient) NewBssopenapiClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewFnfClient() (*rpc.Client, error) {
 	productCode := "fnf"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2436,6 +2496,7 @@ func (client *AliyunClient) NewFnfClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewRosClient() (*rpc.Client, error) {
 	productCode := "ros"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2459,6 +2520,7 @@ func (client *AliyunClient) NewRosClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewPvtzClient() (*rpc.Client, error) {
 	productCode := "pvtz"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2484,6 +2546,7 @@ func (client *AliyunClient) NewPvtzClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewPrivatelinkClient() (*rpc.Client, error) {
 	productCode := "privatelink"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2507,6 +2570,7 @@ func (client *AliyunClient) NewPrivatelinkClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewDcdnClient() (*rpc.Client, error) {
 	productCode := "dcdn"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2532,6 +2596,7 @@ func (client *AliyunClient) NewDcdnClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewOdpsClient() (*roa.Client, error) {
 	productCode := "maxcompute"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2555,6 +2620,7 @@ func (client *AliyunClient) NewOdpsClient() (*roa.Client, error) {
 
 func (client *AliyunClient) NewRessharingClient() (*rpc.Client, error) {
 	productCode := "resourcesharing"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2578,6 +2644,7 @@ func (client *AliyunClient) NewRessharingClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewGaplusClient() (*rpc.Client, error) {
 	productCode := "ga"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2603,6 +2670,7 @@ func (client *AliyunClient) NewGaplusClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewEciClient() (*rpc.Client, error) {
 	productCode := "eci"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2626,6 +2694,7 @@ func (client *AliyunClient) NewEciClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewActiontrailClient() (*rpc.Client, error) {
 	productCode := "actiontrail"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2649,6 +2718,7 @@ func (client *AliyunClient) NewActiontrailClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewMseClient() (*rpc.Client, error) {
 	productCode := "mse"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, exist := client.config.Endpoints.Load(productCode); !exist || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2672,6 +2742,7 @@ func (client *AliyunClient) NewMseClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewHitsdbClient() (*rpc.Client, error) {
 	productCode := "hitsdb"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2695,6 +2766,7 @@ func (client *AliyunClient) NewHitsdbClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewAistudioClient() (*rpc.Client, error) {
 	productCode := "brain_industrial"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2720,6 +2792,7 @@ func (client *AliyunClient) NewAistudioClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewEipanycastClient() (*rpc.Client, error) {
 	productCode := "eipanycast"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2745,6 +2818,7 @@ func (client *AliyunClient) NewEipanycastClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewOosClient() (*rpc.Client, error) {
 	productCode := "oos"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2768,6 +2842,7 @@ func (client *AliyunClient) NewOosClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewImsClient() (*rpc.Client, error) {
 	productCode := "ims"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2793,6 +2868,7 @@ func (client *AliyunClient) NewImsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewRamClient() (*rpc.Client, error) {
 	productCode := "ram"
+	client.waitRateLimit(productCode)
 	endpoint := "ram.aliyuncs.com"
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		client.config.Endpoints.Store(productCode, endpoint)
@@ -2819,6 +2895,7 @@ func (client *AliyunClient) NewRamClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewResourcemanagerClient() (*rpc.Client, error) {
 	productCode := "resourcemanager"
+	client.waitRateLimit(productCode)
 	endpoint := "resourcemanager.aliyuncs.com"
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		client.config.Endpoints.Store(productCode, endpoint)
@@ -2845,6 +2922,7 @@ func (client *AliyunClient) NewResourcemanagerClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewQuotasClient() (*rpc.Client, error) {
 	productCode := "quotas"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2870,6 +2948,7 @@ func (client *AliyunClient) NewQuotasClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewQuotasClientV2() (*openapi.Client, error) {
 	productCode := "quotas"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2896,6 +2975,7 @@ func (client *AliyunClient) NewQuotasClientV2() (*openapi.Client, error) {
 
 func (client *AliyunClient) NewNasClient() (*rpc.Client, error) {
 	productCode := "nas"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2919,6 +2999,7 @@ func (client *AliyunClient) NewNasClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewDmsenterpriseClient() (*rpc.Client, error) {
 	productCode := "dms_enterprise"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2944,6 +3025,7 @@ func (client *AliyunClient) NewDmsenterpriseClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewHcsSgwClient() (*rpc.Client, error) {
 	productCode := "sgw"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2966,6 +3048,7 @@ func (client *AliyunClient) NewHcsSgwClient() (*rpc.Client, error) {
 }
 func (client *AliyunClient) NewDdoscooClient() (*rpc.Client, error) {
 	productCode := "ddoscoo"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -2989,6 +3072,7 @@ func (client *AliyunClient) NewDdoscooClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewSlbClient() (*rpc.Client, error) {
 	productCode := "slb"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3012,6 +3096,7 @@ func (client *AliyunClient) NewSlbClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewKmsClient() (*rpc.Client, error) {
 	productCode := "kms"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3037,6 +3122,7 @@ func (client *AliyunClient) NewKmsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewAlidnsClient() (*rpc.Client, error) {
 	productCode := "alidns"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3062,6 +3148,7 @@ func (client *AliyunClient) NewAlidnsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewHbaseClient() (*rpc.Client, error) {
 	productCode := "hbase"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3085,6 +3172,7 @@ func (client *AliyunClient) NewHbaseClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewDmClient() (*rpc.Client, error) {
 	productCode := "dm"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3108,6 +3196,7 @@ func (client *AliyunClient) NewDmClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewEventbridgeClient() (*rpc.Client, error) {
 	productCode := "eventbridge"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3131,6 +3220,7 @@ func (client *AliyunClient) NewEventbridgeClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewOnsproxyClient() (*rpc.Client, error) {
 	productCode := "amqp"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3154,6 +3244,7 @@ func (client *AliyunClient) NewOnsproxyClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewCdsClient() (*rpc.Client, error) {
 	productCode := "cassandra"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3179,6 +3270,7 @@ func (client *AliyunClient) NewCdsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewHbrClient() (*rpc.Client, error) {
 	productCode := "hbr"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3204,6 +3296,7 @@ func (client *AliyunClient) NewHbrClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewCasClient() (*rpc.Client, error) {
 	productCode := "cas"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		// Currently, the cas is not regional
@@ -3232,6 +3325,7 @@ func (client *AliyunClient) NewCasClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewArmsClient() (*rpc.Client, error) {
 	productCode := "arms"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3257,6 +3351,7 @@ func (client *AliyunClient) NewArmsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewCloudfwClient() (*rpc.Client, error) {
 	productCode := "cloudfw"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3282,6 +3377,7 @@ func (client *AliyunClient) NewCloudfwClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewServerlessClient() (*roa.Client, error) {
 	productCode := "sae"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3307,6 +3403,7 @@ func (client *AliyunClient) NewServerlessClient() (*roa.Client, error) {
 
 func (client *AliyunClient) NewAlbClient() (*rpc.Client, error) {
 	productCode := "alb"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3330,6 +3427,7 @@ func (client *AliyunClient) NewAlbClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewRedisaClient() (*rpc.Client, error) {
 	productCode := "r_kvstore"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3355,6 +3453,7 @@ func (client *AliyunClient) NewRedisaClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewGwsecdClient() (*rpc.Client, error) {
 	productCode := "ecd"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3380,6 +3479,7 @@ func (client *AliyunClient) NewGwsecdClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewCloudphoneClient() (*rpc.Client, error) {
 	productCode := "cloudphone"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3405,6 +3505,7 @@ func (client *AliyunClient) NewCloudphoneClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewScdnClient() (*rpc.Client, error) {
 	productCode := "scdn"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3430,6 +3531,7 @@ func (client *AliyunClient) NewScdnClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewDataworkspublicClient() (*rpc.Client, error) {
 	productCode := "dataworks_public"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3455,6 +3557,7 @@ func (client *AliyunClient) NewDataworkspublicClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewCdnClient() (*rpc.Client, error) {
 	productCode := "cdn"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3481,6 +3584,7 @@ func (client *AliyunClient) NewCdnClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewCddcClient() (*rpc.Client, error) {
 	productCode := "cddc"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3506,6 +3610,7 @@ func (client *AliyunClient) NewCddcClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewMscopensubscriptionClient() (*rpc.Client, error) {
 	productCode := "mscopensubscription"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3531,6 +3636,7 @@ func (client *AliyunClient) NewMscopensubscriptionClient() (*rpc.Client, error)
 
 func (client *AliyunClient) NewSddpClient() (*rpc.Client, error) {
 	productCode := "sddp"
+	client.waitRateLimit(productCode)
 	endpoint := "sddp.cn-zhangjiakou.aliyuncs.com"
 	//todo : Fix after Cloud Product fixing Location Configuration
 	//if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
@@ -3555,6 +3661,7 @@ func (client *AliyunClient) NewSddpClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewBastionhostClient() (*rpc.Client, error) {
 	productCode := "bastionhost"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3580,6 +3687,7 @@ func (client *AliyunClient) NewBastionhostClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewSasClient() (*rpc.Client, error) {
 	productCode := "sas"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3605,6 +3713,7 @@ func (client *AliyunClient) NewSasClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewAlidfsClient() (*rpc.Client, error) {
 	productCode := "dfs"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3630,6 +3739,7 @@ func (client *AliyunClient) NewAlidfsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewEhpcClient() (*rpc.Client, error) {
 	productCode := "ehpc"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3655,6 +3765,7 @@ func (client *AliyunClient) NewEhpcClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewEnsClient() (*rpc.Client, error) {
 	productCode := "ens"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3680,6 +3791,7 @@ func (client *AliyunClient) NewEnsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewIotClient() (*rpc.Client, error) {
 	productCode := "iot"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3705,6 +3817,7 @@ func (client *AliyunClient) NewIotClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewImmClient() (*rpc.Client, error) {
 	productCode := "imm"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3730,6 +3843,7 @@ func (client *AliyunClient) NewImmClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewClickhouseClient() (*rpc.Client, error) {
 	productCode := "clickhouse"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3755,6 +3869,7 @@ func (client *AliyunClient) NewClickhouseClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewSelectDBClient() (*rpc.Client, error) {
 	productCode := "selectdb"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3780,6 +3895,7 @@ func (client *AliyunClient) NewSelectDBClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewDtsClient() (*rpc.Client, error) {
 	productCode := "dts"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3805,6 +3921,7 @@ func (client *AliyunClient) NewDtsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewDgClient() (*rpc.Client, error) {
 	productCode := "dg"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3830,6 +3947,7 @@ func (client *AliyunClient) NewDgClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewCloudssoClient() (*rpc.Client, error) {
 	productCode := "cloudsso"
+	client.waitRateLimit(productCode)
 	endpoint := "cloudsso"
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3855,6 +3973,7 @@ func (client *AliyunClient) NewCloudssoClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewSwasClient() (*rpc.Client, error) {
 	productCode := "swas_open"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3880,6 +3999,7 @@ func (client *AliyunClient) NewSwasClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewVsClient() (*rpc.Client, error) {
 	productCode := "vs"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3905,6 +4025,7 @@ func (client *AliyunClient) NewVsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewQuickbiClient() (*rpc.Client, error) {
 	productCode := "quickbi_public"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3930,6 +4051,7 @@ func (client *AliyunClient) NewQuickbiClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewDevopsrdcClient() (*rpc.Client, error) {
 	productCode := "devops_rdc"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3955,6 +4077,7 @@ func (client *AliyunClient) NewDevopsrdcClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewVodClient() (*rpc.Client, error) {
 	productCode := "vod"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -3980,6 +4103,7 @@ func (client *AliyunClient) NewVodClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewOpensearchClient() (*roa.Client, error) {
 	productCode := "opensearch"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4005,6 +4129,7 @@ func (client *AliyunClient) NewOpensearchClient() (*roa.Client, error) {
 
 func (client *AliyunClient) NewGdsClient() (*rpc.Client, error) {
 	productCode := "gdb"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4030,6 +4155,7 @@ func (client *AliyunClient) NewGdsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewDbfsClient() (*rpc.Client, error) {
 	productCode := "dbfs"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4055,6 +4181,7 @@ func (client *AliyunClient) NewDbfsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewEaisClient() (*rpc.Client, error) {
 	productCode := "eais"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4080,6 +4207,7 @@ func (client *AliyunClient) NewEaisClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewCloudauthClient() (*rpc.Client, error) {
 	productCode := "cloudauth"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4105,6 +4233,7 @@ func (client *AliyunClient) NewCloudauthClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewImpClient() (*rpc.Client, error) {
 	productCode := "imp"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4130,6 +4259,7 @@ func (client *AliyunClient) NewImpClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewMhubClient() (*rpc.Client, error) {
 	productCode := "mhub"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4152,6 +4282,7 @@ func (client *AliyunClient) NewMhubClient() (*rpc.Client, error) {
 }
 func (client *AliyunClient) NewServicemeshClient() (*rpc.Client, error) {
 	productCode := "servicemesh"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4177,6 +4308,7 @@ func (client *AliyunClient) NewServicemeshClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewAcrClient() (*rpc.Client, error) {
 	productCode := "cr"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4202,6 +4334,7 @@ func (client *AliyunClient) NewAcrClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewEdsuserClient() (*rpc.Client, error) {
 	productCode := "eds_user"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4227,6 +4360,7 @@ func (client *AliyunClient) NewEdsuserClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewEmrClient() (*rpc.Client, error) {
 	productCode := "emr"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4252,6 +4386,7 @@ func (client *AliyunClient) NewEmrClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewDdsClient() (*rpc.Client, error) {
 	productCode := "dds"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
@@ -4282,6 +4417,7 @@ func (client *AliyunClient) NewDdsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewAlikafkaClient() (*rpc.Client, error) {
 	productCode := "alikafka"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4308,6 +4444,7 @@ func (client *AliyunClient) NewAlikafkaClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewEssClient() (*rpc.Client, error) {
 	productCode := "ess"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4334,6 +4471,7 @@ func (client *AliyunClient) NewEssClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewDdosbasicClient() (*rpc.Client, error) {
 	productCode := "antiddos_public"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4360,6 +4498,7 @@ func (client *AliyunClient) NewDdosbasicClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewSmartagClient() (*rpc.Client, error) {
 	productCode := "smartag"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4385,6 +4524,7 @@ func (client *AliyunClient) NewSmartagClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewTagClient() (*rpc.Client, error) {
 	productCode := "tag"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4410,6 +4550,7 @@ func (client *AliyunClient) NewTagClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewEdasClient() (*roa.Client, error) {
 	productCode := "edas"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4435,6 +4576,7 @@ func (client *AliyunClient) NewEdasClient() (*roa.Client, error) {
 
 func (client *AliyunClient) NewEdasschedulerxClient() (*rpc.Client, error) {
 	productCode := "schedulerx2"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4461,6 +4603,7 @@ func (client *AliyunClient) NewEdasschedulerxClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewEhsClient() (*rpc.Client, error) {
 	productCode := "ehpc"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4486,6 +4629,7 @@ func (client *AliyunClient) NewEhsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewDysmsClient() (*rpc.Client, error) {
 	productCode := "sms"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4511,6 +4655,7 @@ func (client *AliyunClient) NewDysmsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewFcClient() (*roa.Client, error) {
 	productCode := "fc_open"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4542,6 +4687,7 @@ func (client *AliyunClient) NewFcClient() (*roa.Client, error) {
 
 func (client *AliyunClient) NewDdosbgpClient() (*rpc.Client, error) {
 	productCode := "ddosbgp"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4567,6 +4713,7 @@ func (client *AliyunClient) NewDdosbgpClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewApigatewayClient() (*rpc.Client, error) {
 	productCode := "apigateway"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4593,6 +4740,7 @@ func (client *AliyunClient) NewApigatewayClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewVpcpeerClient() (*rpc.Client, error) {
 	productCode := "vpcpeer"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4618,6 +4766,7 @@ func (client *AliyunClient) NewVpcpeerClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewCbsClient() (*rpc.Client, error) {
 	productCode := "dbs"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4643,6 +4792,7 @@ func (client *AliyunClient) NewCbsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewNlbClient() (*rpc.Client, error) {
 	productCode := "nlb"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4668,6 +4818,7 @@ func (client *AliyunClient) NewNlbClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewEbsClient() (*rpc.Client, error) {
 	productCode := "ebs"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4693,6 +4844,7 @@ func (client *AliyunClient) NewEbsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewMnsClient() (*rpc.Client, error) {
 	productCode := "mns_open"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4718,6 +4870,7 @@ func (client *AliyunClient) NewMnsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewBpstudioClient() (*rpc.Client, error) {
 	productCode := "bpstudio"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4743,6 +4896,7 @@ func (client *AliyunClient) NewBpstudioClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewDasClient() (*rpc.Client, error) {
 	productCode := "das"
+	client.waitRateLimit(productCode)
 	endpoint := "das.cn-shanghai.aliyuncs.com"
 	// missing das endpoint setting in the location
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
@@ -4770,6 +4924,7 @@ func (client *AliyunClient) NewDasClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewCloudfirewallClient() (*rpc.Client, error) {
 	productCode := "cloudfw"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4796,6 +4951,7 @@ func (client *AliyunClient) NewCloudfirewallClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewThreatdetectionClient() (*rpc.Client, error) {
 	productCode := "sas"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4822,6 +4978,7 @@ func (client *AliyunClient) NewThreatdetectionClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewSrvcatalogClient() (*rpc.Client, error) {
 	productCode := "servicecatalog"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4848,6 +5005,7 @@ func (client *AliyunClient) NewSrvcatalogClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewVpcPeerClient() (*rpc.Client, error) {
 	productCode := "vpcpeer"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4874,6 +5032,7 @@ func (client *AliyunClient) NewVpcPeerClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewEfloClient() (*rpc.Client, error) {
 	productCode := "eflo"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4900,6 +5059,7 @@ func (client *AliyunClient) NewEfloClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewOceanbaseClient() (*rpc.Client, error) {
 	productCode := "oceanbasepro"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4925,6 +5085,7 @@ func (client *AliyunClient) NewOceanbaseClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewBeebotClient() (*rpc.Client, error) {
 	productCode := "chatbot"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4951,6 +5112,7 @@ func (client *AliyunClient) NewBeebotClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewComputenestClient() (*rpc.Client, error) {
 	productCode := "computenest"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -4979,6 +5141,7 @@ func (client *AliyunClient) NewComputenestClient() (*rpc.Client, error) {
 }
 func (client *AliyunClient) NewRedisClient() (*rpc.Client, error) {
 	productCode := "r_kvstore"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5003,6 +5166,7 @@ func (client *AliyunClient) NewRedisClient() (*rpc.Client, error) {
 }
 func (client *AliyunClient) NewEipClient() (*rpc.Client, error) {
 	productCode := "vpc"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5027,6 +5191,7 @@ func (client *AliyunClient) NewEipClient() (*rpc.Client, error) {
 }
 func (client *AliyunClient) NewCbwpClient() (*rpc.Client, error) {
 	productCode := "vpc"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5052,6 +5217,7 @@ func (client *AliyunClient) NewCbwpClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewFcv2Client() (*roa.Client, error) {
 	productCode := "fc"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5082,6 +5248,7 @@ func (client *AliyunClient) NewFcv2Client() (*roa.Client, error) {
 }
 func (client *AliyunClient) NewDrdsClient() (*rpc.Client, error) {
 	productCode := "polardbx"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5106,6 +5273,7 @@ func (client *AliyunClient) NewDrdsClient() (*rpc.Client, error) {
 }
 func (client *AliyunClient) NewAckoneClient() (*rpc.Client, error) {
 	productCode := "adcp"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5129,6 +5297,7 @@ func (client *AliyunClient) NewAckoneClient() (*rpc.Client, error) {
 	return conn, nil
 }
 func (client *AliyunClient) NewSlsClient() (*openapi.Client, error) {
+	client.waitRateLimit("sls")
 	config := &openapi.Config{
 		AccessKeyId:     tea.String(client.config.AccessKey),
 		AccessKeySecret: tea.String(client.config.SecretKey),
@@ -5138,7 +5307,7 @@ func (client *AliyunClient) NewSlsClient() (*openapi.Client, error) {
 
 	endpoint := client.config.LogEndpoint
 	if endpoint == "" {
-		endpoint = loadEndpoint(client.config.RegionId, LOGCode)
+		endpoint = client.loadLegacyEndpoint(LOGCode)
 		if endpoint == "" {
 			endpoint = fmt.Sprintf("%s.log.aliyuncs.com", client.config.RegionId)
 		}
@@ -5161,6 +5330,7 @@ func (client *AliyunClient) NewSlsClient() (*openapi.Client, error) {
 }
 func (client *AliyunClient) NewRocketmqClient() (*roa.Client, error) {
 	productCode := "rocketmq"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5186,6 +5356,7 @@ func (client *AliyunClient) NewRocketmqClient() (*roa.Client, error) {
 }
 func (client *AliyunClient) NewResourceCenterClient() (*rpc.Client, error) {
 	productCode := "resourcecenter"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5210,6 +5381,7 @@ func (client *AliyunClient) NewResourceCenterClient() (*rpc.Client, error) {
 }
 func (client *AliyunClient) NewHologramClient() (*roa.Client, error) {
 	productCode := "hologram"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5234,6 +5406,7 @@ func (client *AliyunClient) NewHologramClient() (*roa.Client, error) {
 }
 func (client *AliyunClient) NewRealtimecomputeClient() (*rpc.Client, error) {
 	productCode := "foasconsole"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5258,6 +5431,7 @@ func (client *AliyunClient) NewRealtimecomputeClient() (*rpc.Client, error) {
 }
 func (client *AliyunClient) NewVpngatewayClient() (*rpc.Client, error) {
 	productCode := "vpc"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5282,6 +5456,7 @@ func (client *AliyunClient) NewVpngatewayClient() (*rpc.Client, error) {
 }
 func (client *AliyunClient) NewAckClient() (*roa.Client, error) {
 	productCode := "cs"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5310,13 +5485,14 @@ func (client *AliyunClient) NewOssClient() (*openapi.Client, error) {
 	config := &client.teaRoaOpenapiConfig
 
 	productCode := "oss"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		// Firstly, load endpoint from provider
 		endpoint = client.config.OssEndpoint
 		if endpoint == "" {
 			// Secondly, load endpoint from environment
-			endpoint = loadEndpoint(client.config.RegionId, OSSCode)
+			endpoint = client.loadLegacyEndpoint(OSSCode)
 		}
 		if endpoint == "" {
 			// Thirdly, load endpoint from common method
@@ -5355,6 +5531,7 @@ func (client *AliyunClient) NewOssClient() (*openapi.Client, error) {
 }
 func (client *AliyunClient) NewExpressconnectClient() (*rpc.Client, error) {
 	productCode := "vpc"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5379,6 +5556,7 @@ func (client *AliyunClient) NewExpressconnectClient() (*rpc.Client, error) {
 }
 func (client *AliyunClient) NewCloudmonitorserviceClient() (*rpc.Client, error) {
 	productCode := "cms"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5401,6 +5579,7 @@ func (client *AliyunClient) NewCloudmonitorserviceClient() (*rpc.Client, error)
 }
 func (client *AliyunClient) NewWafv3Client() (*rpc.Client, error) {
 	productCode := "waf_openapi"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5425,6 +5604,7 @@ func (client *AliyunClient) NewWafv3Client() (*rpc.Client, error) {
 }
 func (client *AliyunClient) NewDfsClient() (*rpc.Client, error) {
 	productCode := "dfs"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5450,6 +5630,7 @@ func (client *AliyunClient) NewDfsClient() (*rpc.Client, error) {
 
 func (client *AliyunClient) NewCenClient() (*rpc.Client, error) {
 	productCode := "cbn"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5474,6 +5655,7 @@ func (client *AliyunClient) NewCenClient() (*rpc.Client, error) {
 }
 func (client *AliyunClient) NewExpressconnectrouterClient() (*rpc.Client, error) {
 	productCode := "expressconnectrouter"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5498,6 +5680,7 @@ func (client *AliyunClient) NewExpressconnectrouterClient() (*rpc.Client, error)
 }
 func (client *AliyunClient) NewAligreenClient() (*rpc.Client, error) {
 	productCode := "green"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5522,6 +5705,7 @@ func (client *AliyunClient) NewAligreenClient() (*rpc.Client, error) {
 }
 func (client *AliyunClient) NewGovernanceClient() (*rpc.Client, error) {
 	productCode := "governance"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5574,23 +5758,19 @@ func (client *AliyunClient) RpcPost(apiProductCode string, apiVersion string, ap
 	if err != nil {
 		return nil, err
 	}
-	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
-	credential, err := client.config.Credential.GetCredential()
-	if err != nil || credential == nil {
-		return nil, fmt.Errorf("get credential failed. Error: %#v", err)
-	}
-	sdkConfig.SetAccessKeyId(*credential.AccessKeyId)
-	sdkConfig.SetAccessKeySecret(*credential.AccessKeySecret)
-	sdkConfig.SetSecurityToken(*credential.SecurityToken)
-	conn, err := rpc.NewClient(&sdkConfig)
+	conn, err := client.getRpcClient(apiProductCode, endpoint)
 	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the %s api client: %#v", apiProductCode, err)
+		return nil, err
 	}
 	runtime := &util.RuntimeOptions{}
 	runtime.SetAutoretry(autoRetry)
-	response, err := conn.DoRequest(tea.String(apiName), nil, tea.String("POST"), tea.String(apiVersion), tea.String("AK"), query, body, runtime)
-	return response, formatError(response, err)
+	return client.doWithRetryPolicy(apiProductCode, apiName, func() (map[string]interface{}, error) {
+		start := time.Now()
+		response, err := conn.DoRequest(tea.String(apiName), nil, tea.String("POST"), tea.String(apiVersion), tea.String("AK"), query, body, runtime)
+		err = formatError(response, err)
+		client.traceRpc(apiProductCode, apiVersion, apiName, "POST", endpoint, query, body, start, response, err)
+		return response, err
+	})
 }
 
 // RpcPost invoking RPC API request with POST method
@@ -5611,23 +5791,35 @@ func (client *AliyunClient) RpcPostWithEndpoint(apiProductCode string, apiVersio
 			return nil, err
 		}
 	}
-	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
-	credential, err := client.config.Credential.GetCredential()
-	if err != nil || credential == nil {
-		return nil, fmt.Errorf("get credential failed. Error: %#v", err)
-	}
-	sdkConfig.SetAccessKeyId(*credential.AccessKeyId)
-	sdkConfig.SetAccessKeySecret(*credential.AccessKeySecret)
-	sdkConfig.SetSecurityToken(*credential.SecurityToken)
-	conn, err := rpc.NewClient(&sdkConfig)
+	conn, err := client.getRpcClient(apiProductCode, endpoint)
 	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the %s api client: %#v", apiProductCode, err)
+		return nil, err
 	}
 	runtime := &util.RuntimeOptions{}
 	runtime.SetAutoretry(autoRetry)
-	response, err := conn.DoRequest(tea.String(apiName), nil, tea.String("POST"), tea.String(apiVersion), tea.String("AK"), query, body, runtime)
-	return response, formatError(response, err)
+	return client.doWithRetryPolicy(apiProductCode, apiName, func() (map[string]interface{}, error) {
+		start := time.Now()
+		response, err := conn.DoRequest(tea.String(apiName), nil, tea.String("POST"), tea.String(apiVersion), tea.String("AK"), query, body, runtime)
+		err = formatError(response, err)
+		client.traceRpc(apiProductCode, apiVersion, apiName, "POST", endpoint, query, body, start, response, err)
+		return response, err
+	})
+}
+
+// traceRpc writes the rpc api call into the trace file, if it is set.
+func (client *AliyunClient) traceRpc(product, version, action, method, endpoint string, query, body map[string]interface{}, start time.Time, response map[string]interface{}, err error) {
+	if client.config.tracer == nil {
+		return
+	}
+	client.config.tracer.Trace(&TraceRecord{
+		TraceId:  client.config.TerraformTraceId,
+		Product:  product,
+		Action:   action,
+		Version:  version,
+		Method:   method,
+		Endpoint: endpoint,
+		Request:  mergeParameters(query, body),
+	}, start, response, err)
 }
 
 func formatError(response map[string]interface{}, err error) error {
@@ -5671,27 +5863,51 @@ func (client *AliyunClient) RpcGet(apiProductCode string, apiVersion string, api
 	if err != nil {
 		return nil, err
 	}
-	sdkConfig := client.teaSdkConfig
-	sdkConfig.SetEndpoint(endpoint)
-	credential, err := client.config.Credential.GetCredential()
-	if err != nil || credential == nil {
-		return nil, fmt.Errorf("get credential failed. Error: %#v", err)
+	conn, err := client.getRpcClient(apiProductCode, endpoint)
+	if err != nil {
+		return nil, err
 	}
-	sdkConfig.SetAccessKeyId(*credential.AccessKeyId)
-	sdkConfig.SetAccessKeySecret(*credential.AccessKeySecret)
-	sdkConfig.SetSecurityToken(*credential.SecurityToken)
-	conn, err := rpc.NewClient(&sdkConfig)
+	runtime := &util.RuntimeOptions{}
+	runtime.SetAutoretry(true)
+	return client.doWithRetryPolicy(apiProductCode, apiName, func() (map[string]interface{}, error) {
+		start := time.Now()
+		response, err := conn.DoRequest(tea.String(apiName), nil, tea.String("GET"), tea.String(apiVersion), tea.String("AK"), query, body, runtime)
+		err = formatError(response, err)
+		client.traceRpc(apiProductCode, apiVersion, apiName, "GET", endpoint, query, body, start, response, err)
+		return response, err
+	})
+}
+
+// RoaRequest invoking ROA API request
+// parameters:
+//
+//	apiProductCode: API Product code, its value equals to the gateway code of the API
+//	apiVersion - API version
+//	apiName - API Name
+//	method - HTTP method, like GET, POST, PUT and DELETE
+//	pathname - API path, like /clusters
+//	query - API parameters in query
+//	body - API parameters in body
+func (client *AliyunClient) RoaRequest(apiProductCode string, apiVersion string, apiName string, method string, pathname string, query map[string]*string, body interface{}) (map[string]interface{}, error) {
+	apiProductCode = strings.ToLower(ConvertKebabToSnake(apiProductCode))
+	endpoint, err := client.loadApiEndpoint(apiProductCode)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := client.NewTeaRoaCommonClient(endpoint)
 	if err != nil {
-		return nil, fmt.Errorf("unable to initialize the %s api client: %#v", apiProductCode, err)
+		return nil, err
 	}
 	runtime := &util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
-	response, err := conn.DoRequest(tea.String(apiName), nil, tea.String("GET"), tea.String(apiVersion), tea.String("AK"), query, body, runtime)
-	return response, formatError(response, err)
+	return client.doWithRetryPolicy(apiProductCode, apiName, func() (map[string]interface{}, error) {
+		return conn.DoRequestWithAction(tea.String(apiName), tea.String(apiVersion), nil, tea.String(method), tea.String("AK"), tea.String(pathname), query, nil, body, runtime)
+	})
 }
 
 func (client *AliyunClient) NewPaiworkspaceClient() (*roa.Client, error) {
 	productCode := "paiworkspace"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5718,6 +5934,7 @@ func (client *AliyunClient) NewPaiworkspaceClient() (*roa.Client, error) {
 }
 func (client *AliyunClient) NewVpcipamClient() (*rpc.Client, error) {
 	productCode := "vpcipam"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5742,6 +5959,7 @@ func (client *AliyunClient) NewVpcipamClient() (*rpc.Client, error) {
 }
 func (client *AliyunClient) NewGwlbClient() (*rpc.Client, error) {
 	productCode := "gwlb"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5766,6 +5984,7 @@ func (client *AliyunClient) NewGwlbClient() (*rpc.Client, error) {
 }
 func (client *AliyunClient) NewEsaClient() (*rpc.Client, error) {
 	productCode := "dcdnservices"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5850,6 +6069,7 @@ func (client *AliyunClient) GenRoaParam(action, method, version, path string) *o
 }
 func (client *AliyunClient) NewLiveClient() (*rpc.Client, error) {
 	productCode := "live"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5874,6 +6094,7 @@ func (client *AliyunClient) NewLiveClient() (*rpc.Client, error) {
 }
 func (client *AliyunClient) NewPaiClient() (*roa.Client, error) {
 	productCode := "eas"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5900,6 +6121,7 @@ func (client *AliyunClient) NewPaiClient() (*roa.Client, error) {
 }
 func (client *AliyunClient) NewSchedulerxClient() (*rpc.Client, error) {
 	productCode := "edasschedulerx"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
@@ -5924,6 +6146,7 @@ func (client *AliyunClient) NewSchedulerxClient() (*rpc.Client, error) {
 }
 func (client *AliyunClient) NewApigClient() (*roa.Client, error) {
 	productCode := "nativeapigw"
+	client.waitRateLimit(productCode)
 	endpoint := ""
 	if v, ok := client.config.Endpoints.Load(productCode); !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
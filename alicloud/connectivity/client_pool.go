@@ -0,0 +1,87 @@
+package connectivity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	rpc "github.com/alibabacloud-go/tea-rpc/client"
+	"github.com/alibabacloud-go/tea/tea"
+	credential "github.com/aliyun/credentials-go/credentials"
+)
+
+// rpcClientKey identifies a pooled rpc client. The credential generation changes whenever
+// the STS credentials rotate, which leads to a new client with the refreshed credentials.
+type rpcClientKey struct {
+	productCode          string
+	endpoint             string
+	credentialGeneration string
+}
+
+// rpcClientPool caches the rpc clients used by RpcPost, RpcPostWithEndpoint and RpcGet.
+// The rpc client does not change its state while sending requests, so one client is shared
+// by all of the concurrent requests to the same product and endpoint.
+type rpcClientPool struct {
+	mutex   sync.RWMutex
+	clients map[rpcClientKey]*rpc.Client
+}
+
+func (p *rpcClientPool) get(key rpcClientKey) (*rpc.Client, bool) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+	conn, ok := p.clients[key]
+	return conn, ok
+}
+
+// put stores the client and drops the clients built with the stale credentials of the same product and endpoint.
+// If another client with the same key has been stored meanwhile, that client is returned instead.
+func (p *rpcClientPool) put(key rpcClientKey, conn *rpc.Client) *rpc.Client {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.clients == nil {
+		p.clients = make(map[rpcClientKey]*rpc.Client)
+	}
+	if v, ok := p.clients[key]; ok {
+		return v
+	}
+	for k := range p.clients {
+		if k.productCode == key.productCode && k.endpoint == key.endpoint {
+			delete(p.clients, k)
+		}
+	}
+	p.clients[key] = conn
+	return conn
+}
+
+// credentialGeneration returns a digest of the credential, so that the secrets are not kept in the pool keys.
+func credentialGeneration(c *credential.CredentialModel) string {
+	sum := sha256.Sum256([]byte(tea.StringValue(c.AccessKeyId) + "\n" + tea.StringValue(c.AccessKeySecret) + "\n" + tea.StringValue(c.SecurityToken)))
+	return hex.EncodeToString(sum[:8])
+}
+
+// getRpcClient returns the pooled rpc client of the product and endpoint built with the current credentials.
+func (client *AliyunClient) getRpcClient(productCode, endpoint string) (*rpc.Client, error) {
+	credential, err := client.config.Credential.GetCredential()
+	if err != nil || credential == nil {
+		return nil, fmt.Errorf("get credential failed. Error: %#v", err)
+	}
+	key := rpcClientKey{
+		productCode:          productCode,
+		endpoint:             endpoint,
+		credentialGeneration: credentialGeneration(credential),
+	}
+	if conn, ok := client.rpcClients.get(key); ok {
+		return conn, nil
+	}
+	sdkConfig := client.teaSdkConfig
+	sdkConfig.SetEndpoint(endpoint)
+	sdkConfig.SetAccessKeyId(tea.StringValue(credential.AccessKeyId))
+	sdkConfig.SetAccessKeySecret(tea.StringValue(credential.AccessKeySecret))
+	sdkConfig.SetSecurityToken(tea.StringValue(credential.SecurityToken))
+	conn, err := rpc.NewClient(&sdkConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the %s api client: %#v", productCode, err)
+	}
+	return client.rpcClients.put(key, conn), nil
+}
@@ -0,0 +1,92 @@
+package connectivity
+
+import (
+	"testing"
+
+	rpc "github.com/alibabacloud-go/tea-rpc/client"
+	"github.com/alibabacloud-go/tea/tea"
+	credential "github.com/aliyun/credentials-go/credentials"
+)
+
+func newTestPoolClient(t testing.TB) *AliyunClient {
+	cred, err := credential.NewCredential(&credential.Config{
+		Type:            tea.String("access_key"),
+		AccessKeyId:     tea.String("test-access-key"),
+		AccessKeySecret: tea.String("test-secret-key"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := &Config{RegionId: "cn-hangzhou", AccessKey: "test-access-key", SecretKey: "test-secret-key", Credential: cred}
+	teaSdkConfig, err := config.getTeaDslSdkConfig(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &AliyunClient{config: config, teaSdkConfig: teaSdkConfig}
+}
+
+func TestGetRpcClient(t *testing.T) {
+	client := newTestPoolClient(t)
+	conn, err := client.getRpcClient("ecs", "ecs.aliyuncs.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := client.getRpcClient("ecs", "ecs.aliyuncs.com"); again != conn {
+		t.Fatal("the rpc client of the same product and endpoint should be reused.")
+	}
+	if other, _ := client.getRpcClient("vpc", "vpc.aliyuncs.com"); other == conn {
+		t.Fatal("the rpc client of another product should not be reused.")
+	}
+
+	client.config.Credential, _ = credential.NewCredential(&credential.Config{
+		Type:            tea.String("sts"),
+		AccessKeyId:     tea.String("rotated-access-key"),
+		AccessKeySecret: tea.String("rotated-secret-key"),
+		SecurityToken:   tea.String("rotated-token"),
+	})
+	rotated, err := client.getRpcClient("ecs", "ecs.aliyuncs.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rotated == conn {
+		t.Fatal("the rpc client should be rebuilt after the credentials rotate.")
+	}
+	if accessKeyId, _ := rotated.GetAccessKeyId(); tea.StringValue(accessKeyId) != "rotated-access-key" {
+		t.Fatalf("the rebuilt rpc client should use the rotated credentials, got %s.", tea.StringValue(accessKeyId))
+	}
+	if len(client.rpcClients.clients) != 2 {
+		t.Fatalf("the stale rpc client should be dropped, got %d clients.", len(client.rpcClients.clients))
+	}
+}
+
+// BenchmarkNewRpcClient measures building an rpc client per call, which was done before the pool.
+func BenchmarkNewRpcClient(b *testing.B) {
+	client := newTestPoolClient(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		credential, err := client.config.Credential.GetCredential()
+		if err != nil {
+			b.Fatal(err)
+		}
+		sdkConfig := client.teaSdkConfig
+		sdkConfig.SetEndpoint("ecs.aliyuncs.com")
+		sdkConfig.SetAccessKeyId(tea.StringValue(credential.AccessKeyId))
+		sdkConfig.SetAccessKeySecret(tea.StringValue(credential.AccessKeySecret))
+		sdkConfig.SetSecurityToken(tea.StringValue(credential.SecurityToken))
+		if _, err := rpc.NewClient(&sdkConfig); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGetRpcClient(b *testing.B) {
+	client := newTestPoolClient(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := client.getRpcClient("ecs", "ecs.aliyuncs.com"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
 	credential "github.com/aliyun/credentials-go/credentials"
+	"github.com/aliyun/credentials-go/credentials/providers"
 )
 
 var securityCredURL = "http://100.100.100.200/latest/meta-data/ram/security-credentials/"
@@ -39,12 +40,27 @@ type Config struct {
 	SecureTransport      string
 	MaxRetryTimeout      int
 	Credential           credential.Credential
+	CredentialProcess    string
+	DefaultTags          map[string]interface{}
+	IgnoreTags           *IgnoreTags
+	RateLimits           map[string]RateLimit
+	RetryPolicy          *RetryPolicy
+	RecordMode           string
+	CassetteFile         string
+	recorder             *Recorder
+	TraceFile            string
+	tracer               *Tracer
+	EndpointType         string
+	EndpointCacheFile    string
+	EndpointCacheTTL     int
+	endpointCache        *EndpointCache
 
 	RamRoleArn               string
 	RamRoleSessionName       string
 	RamRolePolicy            string
 	RamRoleExternalId        string
 	RamRoleSessionExpiration int
+	RamRoleChain             []AssumeRole
 	AssumeRoleWithOidc       *AssumeRoleWithOidc
 	Endpoints                *sync.Map
 	SignVersion              *sync.Map
@@ -192,6 +208,15 @@ type Config struct {
 	ComputeNestEndpoint         string
 }
 
+// AssumeRole describes a hop of the chained assume_role blocks.
+type AssumeRole struct {
+	RoleArn           string
+	SessionName       string
+	Policy            string
+	ExternalId        string
+	SessionExpiration int
+}
+
 type AssumeRoleWithOidc struct {
 	RoleARN         string
 	DurationSeconds int
@@ -202,6 +227,30 @@ type AssumeRoleWithOidc struct {
 	OIDCToken       string
 }
 
+// IgnoreTags describes the tags which are managed outside of Terraform and ignored when reading the resource tags.
+type IgnoreTags struct {
+	Keys        []string
+	KeyPrefixes []string
+}
+
+// Ignored reports whether the tag key matches one of the ignored keys or key prefixes.
+func (i *IgnoreTags) Ignored(key string) bool {
+	if i == nil {
+		return false
+	}
+	for _, k := range i.Keys {
+		if key == k {
+			return true
+		}
+	}
+	for _, prefix := range i.KeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *Config) loadAndValidate() error {
 	err := c.validateRegion()
 	if err != nil {
@@ -256,6 +305,9 @@ func (c *Config) setAuthByAssumeRole() (err error) {
 	if c.AccessKey == "" || c.RamRoleArn == "" {
 		return
 	}
+	if len(c.RamRoleChain) > 0 {
+		return c.setAuthByAssumeRoleChain()
+	}
 
 	config := new(credential.Config).
 		SetType("ram_role_arn").
@@ -291,6 +343,84 @@ func (c *Config) setAuthByAssumeRole() (err error) {
 	return nil
 }
 
+// setAuthByAssumeRoleChain assumes the RamRoleArn and then the roles of the RamRoleChain in order. Every hop
+// is assumed with the credentials of the previous one, and refreshes them when they are going to expire,
+// so the whole chain is refreshed automatically.
+func (c *Config) setAuthByAssumeRoleChain() (err error) {
+	var previous providers.CredentialsProvider
+	if c.SecurityToken != "" {
+		previous, err = providers.NewStaticSTSCredentialsProviderBuilder().
+			WithAccessKeyId(c.AccessKey).
+			WithAccessKeySecret(c.SecretKey).
+			WithSecurityToken(c.SecurityToken).
+			Build()
+	} else {
+		previous, err = providers.NewStaticAKCredentialsProviderBuilder().
+			WithAccessKeyId(c.AccessKey).
+			WithAccessKeySecret(c.SecretKey).
+			Build()
+	}
+	if err != nil {
+		return
+	}
+
+	hops := append([]AssumeRole{{
+		RoleArn:           c.RamRoleArn,
+		SessionName:       c.RamRoleSessionName,
+		Policy:            c.RamRolePolicy,
+		ExternalId:        c.RamRoleExternalId,
+		SessionExpiration: c.RamRoleSessionExpiration,
+	}}, c.RamRoleChain...)
+	for i, hop := range hops {
+		log.Printf("[INFO] Assume RAM Role %s specified in the provider block assume_role { ... } %d", hop.RoleArn, i)
+		previous, err = providers.NewRAMRoleARNCredentialsProviderBuilder().
+			WithCredentialsProvider(previous).
+			WithRoleArn(hop.RoleArn).
+			WithRoleSessionName(hop.SessionName).
+			WithPolicy(hop.Policy).
+			WithExternalId(hop.ExternalId).
+			WithDurationSeconds(hop.SessionExpiration).
+			WithStsEndpoint(c.StsEndpoint).
+			WithHttpOptions(&providers.HttpOptions{
+				ConnectTimeout: c.ClientConnectTimeout,
+				ReadTimeout:    c.ClientReadTimeout,
+			}).
+			Build()
+		if err != nil {
+			return fmt.Errorf("building the credential of the assume_role %d (%s) failed. Error: %v", i, hop.RoleArn, err)
+		}
+	}
+
+	provider := credential.FromCredentialsProvider("ram_role_arn", previous)
+	c.Credential = provider
+	credential, err := provider.GetCredential()
+	if err != nil || credential == nil {
+		return fmt.Errorf("refresh chained Ram Role Arn credential failed. Error: %v", err)
+	}
+	c.AccessKey, c.SecretKey, c.SecurityToken = *credential.AccessKeyId, *credential.AccessKeySecret, *credential.SecurityToken
+	return nil
+}
+
+// setAuthByCredentialProcess gets the credentials from the output of the CredentialProcess command, which is run
+// again before the credentials expire.
+func (c *Config) setAuthByCredentialProcess() (err error) {
+	if c.AccessKey != "" || c.CredentialProcess == "" {
+		return
+	}
+	processProvider, err := NewProcessCredentialsProvider(c.CredentialProcess, time.Duration(c.ClientReadTimeout)*time.Millisecond)
+	if err != nil {
+		return
+	}
+	provider := credential.FromCredentialsProvider("credential_process", processProvider)
+	c.Credential = provider
+	credential, err := provider.GetCredential()
+	if err != nil || credential == nil {
+		return fmt.Errorf("refresh the credential of the credential_process failed. Error: %v", err)
+	}
+	c.AccessKey, c.SecretKey, c.SecurityToken = *credential.AccessKeyId, *credential.AccessKeySecret, *credential.SecurityToken
+	return nil
+}
+
 // setAuthCredentialByEcsRoleName aims to access meta to get sts credential
 // Actually, the job should be done by sdk, but currently not all resources and products support alibaba-cloud-sdk-go,
 // and their go sdk does support ecs role name.
@@ -454,6 +584,9 @@ func isExpectedErrors(err error, expectCodes []string) bool {
 	return false
 }
 func (c *Config) RefreshAuthCredential() error {
+	if err := c.setAuthByCredentialProcess(); err != nil {
+		return err
+	}
 	if err := c.setAuthCredentialByEcsRoleName(); err != nil {
 		return err
 	}
@@ -466,7 +599,7 @@ func (c *Config) RefreshAuthCredential() error {
 func (c *Config) getTeaDslSdkConfig(stsSupported bool) (config rpc.Config, err error) {
 	config.SetRegionId(c.RegionId)
 	config.SetUserAgent(c.getUserAgent())
-	credential, err := credential.NewCredential(c.getCredentialConfig(stsSupported))
+	credential, err := c.getTeaCredential(stsSupported)
 	config.SetCredential(credential).
 		SetRegionId(c.RegionId).
 		SetProtocol(c.Protocol).
@@ -480,12 +613,15 @@ func (c *Config) getTeaDslSdkConfig(stsSupported bool) (config rpc.Config, err e
 		config.SetSecureTransport(c.SecureTransport)
 	}
 
+	if c.recorder != nil {
+		config.SetHttpProxy(c.recorder.ProxyURL.String())
+	}
 	return
 }
 func (c *Config) getTeaRoaDslSdkConfig(stsSupported bool) (config roa.Config, err error) {
 	config.SetRegionId(c.RegionId)
 	config.SetUserAgent(c.getUserAgent())
-	credential, err := credential.NewCredential(c.getCredentialConfig(stsSupported))
+	credential, err := c.getTeaCredential(stsSupported)
 	config.SetCredential(credential).
 		SetRegionId(c.RegionId).
 		SetProtocol(c.Protocol).
@@ -498,12 +634,15 @@ func (c *Config) getTeaRoaDslSdkConfig(stsSupported bool) (config roa.Config, er
 	if c.SecureTransport != "" {
 		config.SetSecureTransport(c.SecureTransport)
 	}
+	if c.recorder != nil {
+		config.SetHttpProxy(c.recorder.ProxyURL.String())
+	}
 	return
 }
 func (c *Config) getTeaRpcOpenapiConfig(stsSupported bool) (config openapi.Config, err error) {
 	config.SetRegionId(c.RegionId)
 	config.SetUserAgent(c.getUserAgent())
-	credential, err := credential.NewCredential(c.getCredentialConfig(stsSupported))
+	credential, err := c.getTeaCredential(stsSupported)
 	config.SetCredential(credential).
 		SetRegionId(c.RegionId).
 		SetProtocol(c.Protocol).
@@ -523,12 +662,15 @@ func (c *Config) getTeaRpcOpenapiConfig(stsSupported bool) (config openapi.Confi
 
 	param := &openapi.GlobalParameters{Queries: query}
 	config.GlobalParameters = param
+	if c.recorder != nil {
+		config.SetHttpProxy(c.recorder.ProxyURL.String())
+	}
 	return
 }
 func (c *Config) getTeaRoaOpenapiConfig(stsSupported bool) (config openapi.Config, err error) {
 	config.SetRegionId(c.RegionId)
 	config.SetUserAgent(c.getUserAgent())
-	credential, err := credential.NewCredential(c.getCredentialConfig(stsSupported))
+	credential, err := c.getTeaCredential(stsSupported)
 	config.SetCredential(credential).
 		SetRegionId(c.RegionId).
 		SetProtocol(c.Protocol).
@@ -546,8 +688,21 @@ func (c *Config) getTeaRoaOpenapiConfig(stsSupported bool) (config openapi.Confi
 
 	param := &openapi.GlobalParameters{Headers: header}
 	config.GlobalParameters = param
+	if c.recorder != nil {
+		config.SetHttpProxy(c.recorder.ProxyURL.String())
+	}
 	return
 }
+
+// getTeaCredential returns the credential of the tea sdk. The credential of the credential_process is used directly,
+// so that it is refreshed by the tea sdk clients before it expires.
+func (c *Config) getTeaCredential(stsSupported bool) (credential.Credential, error) {
+	if c.CredentialProcess != "" && c.Credential != nil && c.RamRoleArn == "" && (stsSupported || c.SecurityToken == "") {
+		return c.Credential, nil
+	}
+	return credential.NewCredential(c.getCredentialConfig(stsSupported))
+}
+
 func (c *Config) getCredentialConfig(stsSupported bool) *credential.Config {
 	credentialType := ""
 	credentialConfig := &credential.Config{}
@@ -0,0 +1,135 @@
+package connectivity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aliyun/credentials-go/credentials/providers"
+)
+
+// ProcessCredentialsProvider gets the credentials from the json output of an external command, like:
+//
+//	{"AccessKeyId": "...", "AccessKeySecret": "...", "SecurityToken": "...", "Expiration": "2024-01-01T00:00:00Z"}
+//
+// The output of the External mode of the Alibaba Cloud CLI, whose keys are access_key_id, access_key_secret and sts_token,
+// is supported as well. The command is run again when the credentials are going to expire.
+type ProcessCredentialsProvider struct {
+	command string
+	timeout time.Duration
+
+	mutex               sync.Mutex
+	credentials         *providers.Credentials
+	expirationTimestamp int64
+}
+
+// NewProcessCredentialsProvider returns the provider running the command with the shell of the system.
+func NewProcessCredentialsProvider(command string, timeout time.Duration) (*ProcessCredentialsProvider, error) {
+	if strings.TrimSpace(command) == "" {
+		return nil, fmt.Errorf("the credential process command is empty")
+	}
+	if timeout <= 0 {
+		timeout = time.Minute
+	}
+	return &ProcessCredentialsProvider{
+		command: command,
+		timeout: timeout,
+	}, nil
+}
+
+type processCredentialsOutput struct {
+	AccessKeyId     string `json:"AccessKeyId"`
+	AccessKeySecret string `json:"AccessKeySecret"`
+	SecurityToken   string `json:"SecurityToken"`
+	Expiration      string `json:"Expiration"`
+
+	CliAccessKeyId     string `json:"access_key_id"`
+	CliAccessKeySecret string `json:"access_key_secret"`
+	CliStsToken        string `json:"sts_token"`
+}
+
+func (p *ProcessCredentialsProvider) run() (*processCredentialsOutput, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.CommandContext(ctx, "cmd.exe", "/C", p.command)
+	} else {
+		cmd = exec.CommandContext(ctx, "sh", "-c", p.command)
+	}
+	// The children of the shell may keep the output open after the shell is killed.
+	cmd.WaitDelay = time.Second
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, fmt.Errorf("the credential process timed out after %s", p.timeout)
+		}
+		return nil, fmt.Errorf("running the credential process failed: %v, stderr: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	output := &processCredentialsOutput{}
+	if err := json.Unmarshal(stdout.Bytes(), output); err != nil {
+		// The output is not logged, which contains the secret.
+		return nil, fmt.Errorf("parsing the output of the credential process failed: %v", err)
+	}
+	if output.AccessKeyId == "" {
+		output.AccessKeyId, output.AccessKeySecret, output.SecurityToken = output.CliAccessKeyId, output.CliAccessKeySecret, output.CliStsToken
+	}
+	if output.AccessKeyId == "" || output.AccessKeySecret == "" {
+		return nil, fmt.Errorf("the output of the credential process does not have the AccessKeyId and AccessKeySecret")
+	}
+	return output, nil
+}
+
+func (p *ProcessCredentialsProvider) needUpdateCredential() bool {
+	if p.credentials == nil {
+		return true
+	}
+	if p.expirationTimestamp == 0 {
+		return false
+	}
+	return p.expirationTimestamp-time.Now().Unix() <= 180
+}
+
+func (p *ProcessCredentialsProvider) GetCredentials() (*providers.Credentials, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.needUpdateCredential() {
+		output, err := p.run()
+		if err != nil {
+			return nil, err
+		}
+		var expirationTimestamp int64
+		if output.Expiration != "" {
+			expiration, err := time.Parse(time.RFC3339, output.Expiration)
+			if err != nil {
+				return nil, fmt.Errorf("parsing the Expiration %s of the credential process failed: %v", output.Expiration, err)
+			}
+			expirationTimestamp = expiration.Unix()
+		}
+		p.credentials = &providers.Credentials{
+			AccessKeyId:     output.AccessKeyId,
+			AccessKeySecret: output.AccessKeySecret,
+			SecurityToken:   output.SecurityToken,
+			ProviderName:    p.GetProviderName(),
+		}
+		p.expirationTimestamp = expirationTimestamp
+	}
+
+	credentials := *p.credentials
+	return &credentials, nil
+}
+
+func (p *ProcessCredentialsProvider) GetProviderName() string {
+	return "credential_process"
+}
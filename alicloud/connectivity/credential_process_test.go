@@ -0,0 +1,65 @@
+package connectivity
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestProcessCredentialsProvider(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the test command requires the sh shell.")
+	}
+	counter := filepath.Join(t.TempDir(), "counter")
+	output := func(expiration time.Time) string {
+		return fmt.Sprintf(`echo x >> %s; echo '{"AccessKeyId": "id", "AccessKeySecret": "secret", "SecurityToken": "token", "Expiration": "%s"}'`,
+			counter, expiration.UTC().Format(time.RFC3339))
+	}
+	runs := func() int {
+		data, _ := os.ReadFile(counter)
+		return len(data) / 2
+	}
+
+	provider, err := NewProcessCredentialsProvider(output(time.Now().Add(time.Hour)), time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		credentials, err := provider.GetCredentials()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if credentials.AccessKeyId != "id" || credentials.AccessKeySecret != "secret" || credentials.SecurityToken != "token" {
+			t.Errorf("getting the credentials got %+v.", credentials)
+		}
+	}
+	if runs() != 1 {
+		t.Errorf("the unexpired credentials should be cached, but the process ran %d times.", runs())
+	}
+
+	provider, _ = NewProcessCredentialsProvider(output(time.Now().Add(time.Minute)), time.Minute)
+	provider.GetCredentials()
+	provider.GetCredentials()
+	if runs() != 3 {
+		t.Errorf("the expiring credentials should be refreshed, but the process ran %d times in total.", runs())
+	}
+
+	provider, _ = NewProcessCredentialsProvider(`echo '{"mode": "StsToken", "access_key_id": "id", "access_key_secret": "secret", "sts_token": "token"}'`, time.Minute)
+	credentials, err := provider.GetCredentials()
+	if err != nil || credentials.AccessKeyId != "id" || credentials.SecurityToken != "token" {
+		t.Errorf("getting the credentials of the cli format got %+v, %v.", credentials, err)
+	}
+
+	for _, command := range []string{"exit 1", "echo invalid", `echo '{"AccessKeyId": "id"}'`, "sleep 5"} {
+		provider, _ = NewProcessCredentialsProvider(command, time.Second)
+		if _, err := provider.GetCredentials(); err == nil {
+			t.Errorf("getting the credentials by %q should fail.", command)
+		}
+	}
+	if _, err := NewProcessCredentialsProvider(" ", time.Minute); err == nil {
+		t.Error("the empty command should be rejected.")
+	}
+}
@@ -10,7 +10,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/location"
 )
@@ -130,6 +130,11 @@ func LoadRegionalEndpoint(region string, serviceCode string) string {
 	return fmt.Sprintf("%s.%s.aliyuncs.com", serviceCode, region)
 }
 
+// LoadRegionalEndpointForType returns the regional endpoint of the service code for the endpoint type.
+func LoadRegionalEndpointForType(region string, serviceCode string, endpointType string) string {
+	return convertEndpointForType(serviceCode, LoadRegionalEndpoint(region, serviceCode), region, endpointType)
+}
+
 func loadEndpoint(region string, serviceCode ServiceCode) string {
 	endpoint := strings.TrimSpace(os.Getenv(fmt.Sprintf("%s_ENDPOINT", string(serviceCode))))
 	if endpoint != "" {
@@ -475,6 +480,53 @@ var regularProductEndpointForIntlRegion = map[string]string{
 	"waf_openapi": "wafopenapi.ap-southeast-1.aliyuncs.com",
 }
 
+// The endpoint types supported by the provider argument endpoint_type.
+const (
+	EndpointTypePublic   = "public"
+	EndpointTypeVpc      = "vpc"
+	EndpointTypeIntranet = "intranet"
+)
+
+// irregularProductEndpointForType specially records those product codes whose vpc or intranet endpoints
+// do not follow the rule <product>-<endpoint type>.<region>.aliyuncs.com.
+// Key: product code, its value equals to the gateway code of the API after converting it to lowercase and using underscores
+// Value: product endpoint keyed by the endpoint type
+var irregularProductEndpointForType = map[string]map[string]string{
+	"oss": {
+		EndpointTypeVpc:      "oss-%s-internal.aliyuncs.com",
+		EndpointTypeIntranet: "oss-%s-internal.aliyuncs.com",
+	},
+	"log": {
+		EndpointTypeVpc:      "%s-intranet.log.aliyuncs.com",
+		EndpointTypeIntranet: "%s-intranet.log.aliyuncs.com",
+	},
+	"ram": {
+		EndpointTypeVpc: "ram.vpc-proxy.aliyuncs.com",
+	},
+}
+
+// convertEndpointForType converts the public endpoint of the product to the endpoint of the endpoint type.
+// The endpoints which are not the public endpoints of Alibaba Cloud are returned as they are.
+func convertEndpointForType(productCode, endpoint, region, endpointType string) string {
+	if endpoint == "" || endpointType == "" || endpointType == EndpointTypePublic {
+		return endpoint
+	}
+	if endpointFmt, ok := irregularProductEndpointForType[productCode][endpointType]; ok {
+		if strings.Contains(endpointFmt, "%s") {
+			endpointFmt = fmt.Sprintf(endpointFmt, region)
+		}
+		return endpointFmt
+	}
+	if !strings.HasSuffix(endpoint, ".aliyuncs.com") || strings.Contains(endpoint, "://") {
+		return endpoint
+	}
+	parts := strings.SplitN(endpoint, ".", 2)
+	if strings.HasSuffix(parts[0], "-"+endpointType) || len(parts) < 2 {
+		return endpoint
+	}
+	return fmt.Sprintf("%s-%s.%s", parts[0], endpointType, parts[1])
+}
+
 // NOTE: The productCode must be lowed.
 func (client *AliyunClient) loadEndpoint(productCode string) error {
 	// Firstly, load endpoint from environment variables
@@ -488,7 +540,40 @@ func (client *AliyunClient) loadEndpoint(productCode string) error {
 		return nil
 	}
 
-	// Secondly, load endpoint from known rules
+	// Secondly, load the public endpoint, and then convert it to the endpoint of the endpoint type
+	if err := client.loadPublicEndpoint(productCode); err != nil {
+		return err
+	}
+	if v, ok := client.config.Endpoints.Load(productCode); ok && client.config.EndpointType != "" && client.config.EndpointType != EndpointTypePublic {
+		endpoint := convertEndpointForType(productCode, v.(string), client.RegionId, client.config.EndpointType)
+		log.Printf("[DEBUG] using the %s endpoint %s of the product %s.", client.config.EndpointType, endpoint, productCode)
+		client.config.Endpoints.Store(productCode, endpoint)
+	}
+	return nil
+}
+
+// loadLegacyEndpoint returns the endpoint of the clients built on the legacy SDKs, which resolve the public
+// endpoint by themselves if the endpoint is empty. So the endpoint is loaded by loadEndpoint when the endpoint
+// type is not public.
+func (client *AliyunClient) loadLegacyEndpoint(serviceCode ServiceCode) string {
+	endpoint := loadEndpoint(client.config.RegionId, serviceCode)
+	if endpoint != "" || client.config.EndpointType == "" || client.config.EndpointType == EndpointTypePublic {
+		return endpoint
+	}
+	productCode := strings.ToLower(string(serviceCode))
+	if err := client.loadEndpoint(productCode); err != nil {
+		log.Printf("[WARN] loading the %s endpoint of the product %s got an error: %#v.", client.config.EndpointType, productCode, err)
+		return ""
+	}
+	if v, ok := client.config.Endpoints.Load(productCode); ok {
+		return v.(string)
+	}
+	return ""
+}
+
+// loadPublicEndpoint loads the public endpoint of the product from the known rules and location service.
+func (client *AliyunClient) loadPublicEndpoint(productCode string) error {
+	// Firstly, load endpoint from known rules
 	if endpointFmt, ok := irregularProductEndpoint[productCode]; ok {
 		if v, ok := irregularProductEndpointForIntlAccount[productCode]; ok && strings.ToLower(client.config.AccountType) == "international" {
 			endpointFmt = v
@@ -503,7 +588,7 @@ func (client *AliyunClient) loadEndpoint(productCode string) error {
 		return nil
 	}
 
-	// Thirdly, load endpoint from location
+	// Secondly, load endpoint from location
 	endpoint, err := client.describeEndpointForService(productCode)
 	if err == nil {
 		if v, ok := regularProductEndpointForIntlAccount[productCode]; ok && strings.ToLower(client.config.AccountType) == "international" {
@@ -527,6 +612,29 @@ func (client *AliyunClient) loadEndpoint(productCode string) error {
 	return err
 }
 
+// ResolveEndpoint returns the endpoint of the product, which is loaded in the same way as the product clients do.
+// NOTE: The productCode must be lowed.
+func (client *AliyunClient) ResolveEndpoint(productCode string) (string, error) {
+	if v, ok := client.config.Endpoints.Load(productCode); ok && v.(string) != "" {
+		return v.(string), nil
+	}
+	if err := client.loadEndpoint(productCode); err != nil {
+		return "", err
+	}
+	if v, ok := client.config.Endpoints.Load(productCode); ok && v.(string) != "" {
+		return v.(string), nil
+	}
+	return "", fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+}
+
+// CachedEndpoints returns the endpoints of the current region and account type in the endpoint cache.
+func (client *AliyunClient) CachedEndpoints() map[string]string {
+	if v, ok := client.config.endpointCache.Endpoints(client.config.AccountType)[client.config.RegionId]; ok {
+		return v
+	}
+	return map[string]string{}
+}
+
 // Load current path endpoint file endpoints.xml, if failed, it will load from environment variables TF_ENDPOINT_PATH
 func (config *Config) loadEndpointFromLocal() error {
 	data, err := ioutil.ReadFile(localEndpointPath)
@@ -566,6 +674,9 @@ func incrementalWait(firstDuration time.Duration, increaseDuration time.Duration
 	}
 }
 func (client *AliyunClient) describeEndpointForService(productCode string) (string, error) {
+	if endpoint, ok := client.config.endpointCache.Get(client.config.RegionId, productCode, client.config.AccountType); ok {
+		return endpoint, nil
+	}
 	locationCode := productCodeToLocationCode[productCode]
 	if locationCode == "" {
 		locationCode = productCode
@@ -578,7 +689,7 @@ func (client *AliyunClient) describeEndpointForService(productCode string) (stri
 		args.Domain = loadEndpoint(client.RegionId, LOCATIONCode)
 	}
 	if args.Domain == "" {
-		args.Domain = "location.aliyuncs.com"
+		args.Domain = convertEndpointForType("location", "location.aliyuncs.com", client.RegionId, client.config.EndpointType)
 	}
 
 	locationClient, err := location.NewClientWithOptions(client.config.RegionId, client.getSdkConfig(), client.config.getAuthCredential(true))
@@ -620,6 +731,9 @@ func (client *AliyunClient) describeEndpointForService(productCode string) (stri
 	if endpointResult == "" {
 		return "", fmt.Errorf("There is no any available endpoint for %s in region %s.", productCode, client.RegionId)
 	}
+	if err := client.config.endpointCache.Put(client.config.RegionId, productCode, client.config.AccountType, endpointResult); err != nil {
+		log.Printf("[WARN] caching the %s endpoint %s got an error: %#v.", productCode, endpointResult, err)
+	}
 	return endpointResult, nil
 }
 
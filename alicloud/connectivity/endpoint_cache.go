@@ -0,0 +1,191 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultEndpointCacheTTL is the default time to live of the cached endpoints in seconds.
+const DefaultEndpointCacheTTL = 86400
+
+// EndpointCache persists the endpoints resolved by the location service into a json file, so they are
+// reused by the provider instances of all of the provider aliases and the later runs until they expire.
+// The endpoints are keyed by the region, product code and account type.
+type EndpointCache struct {
+	CacheFile string
+	TTL       time.Duration
+	mutex     sync.Mutex
+}
+
+type endpointCacheEntry struct {
+	Region      string    `json:"region"`
+	Product     string    `json:"product"`
+	AccountType string    `json:"account_type"`
+	Endpoint    string    `json:"endpoint"`
+	ResolvedAt  time.Time `json:"resolved_at"`
+}
+
+// endpointCaches records the endpoint caches by the cache file, which are shared by the provider aliases.
+var endpointCaches sync.Map
+
+// GetEndpointCache returns the endpoint cache writing into the cache file. The ttl is in seconds.
+func GetEndpointCache(cacheFile string, ttl int) (*EndpointCache, error) {
+	if ttl <= 0 {
+		ttl = DefaultEndpointCacheTTL
+	}
+	if v, ok := endpointCaches.Load(cacheFile); ok {
+		return v.(*EndpointCache), nil
+	}
+	if err := os.MkdirAll(filepath.Dir(cacheFile), 0700); err != nil {
+		return nil, fmt.Errorf("creating the directory of the endpoint cache file %s got an error: %#v", cacheFile, err)
+	}
+	cache := &EndpointCache{
+		CacheFile: cacheFile,
+		TTL:       time.Duration(ttl) * time.Second,
+	}
+	v, _ := endpointCaches.LoadOrStore(cacheFile, cache)
+	return v.(*EndpointCache), nil
+}
+
+func endpointCacheKey(region, productCode, accountType string) string {
+	return strings.ToLower(strings.Join([]string{region, productCode, normalizeAccountType(accountType)}, "/"))
+}
+
+// normalizeAccountType returns the account type in lowercase. The empty account type is Domestic.
+func normalizeAccountType(accountType string) string {
+	if accountType == "" {
+		return "domestic"
+	}
+	return strings.ToLower(accountType)
+}
+
+// load reads the cache file every time, as it may be updated by other processes.
+func (c *EndpointCache) load() map[string]endpointCacheEntry {
+	entries := make(map[string]endpointCacheEntry)
+	data, err := ioutil.ReadFile(c.CacheFile)
+	if err != nil || len(data) == 0 {
+		return entries
+	}
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return make(map[string]endpointCacheEntry)
+	}
+	return entries
+}
+
+// Get returns the cached endpoint. The expired endpoints are not returned.
+func (c *EndpointCache) Get(region, productCode, accountType string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entry, ok := c.load()[endpointCacheKey(region, productCode, accountType)]
+	if !ok || entry.Endpoint == "" || time.Since(entry.ResolvedAt) > c.TTL {
+		return "", false
+	}
+	return entry.Endpoint, true
+}
+
+// Put caches the endpoint. The cache file is replaced atomically, and the expired endpoints are dropped.
+func (c *EndpointCache) Put(region, productCode, accountType, endpoint string) error {
+	if c == nil || endpoint == "" {
+		return nil
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	entries := c.load()
+	for key, entry := range entries {
+		if time.Since(entry.ResolvedAt) > c.TTL {
+			delete(entries, key)
+		}
+	}
+	entries[endpointCacheKey(region, productCode, accountType)] = endpointCacheEntry{
+		Region:      region,
+		Product:     productCode,
+		AccountType: accountType,
+		Endpoint:    endpoint,
+		ResolvedAt:  time.Now(),
+	}
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(c.CacheFile), filepath.Base(c.CacheFile)+".*")
+	if err != nil {
+		return fmt.Errorf("writing the endpoint cache file %s got an error: %#v", c.CacheFile, err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("writing the endpoint cache file %s got an error: %#v", c.CacheFile, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), c.CacheFile)
+}
+
+// Endpoints returns the cached endpoints which are not expired, keyed by the region and then the product code.
+func (c *EndpointCache) Endpoints(accountType string) map[string]map[string]string {
+	result := make(map[string]map[string]string)
+	if c == nil {
+		return result
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	for _, entry := range c.load() {
+		if time.Since(entry.ResolvedAt) > c.TTL || normalizeAccountType(entry.AccountType) != normalizeAccountType(accountType) {
+			continue
+		}
+		if result[entry.Region] == nil {
+			result[entry.Region] = make(map[string]string)
+		}
+		result[entry.Region][entry.Product] = entry.Endpoint
+	}
+	return result
+}
+
+// WriteEndpointsXml writes the endpoints keyed by the region and then the product code in the format of
+// endpoints.xml, which can be loaded by the environment variable TF_ENDPOINT_PATH.
+func WriteEndpointsXml(w io.Writer, endpoints map[string]map[string]string) error {
+	regions := make([]string, 0, len(endpoints))
+	for region := range endpoints {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+	result := Endpoints{}
+	for _, region := range regions {
+		endpoint := Endpoint{Name: region, RegionIds: RegionIds{RegionId: region}}
+		for _, product := range mapKeys(endpoints[region]) {
+			endpoint.Products.Product = append(endpoint.Products.Product, Product{ProductName: product, DomainName: endpoints[region][product]})
+		}
+		result.Endpoint = append(result.Endpoint, endpoint)
+	}
+	data, err := xml.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	_, err = w.Write(append(data, '\n'))
+	return err
+}
+
+func mapKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,86 @@
+package connectivity
+
+import (
+	"bytes"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestEndpointCache(t *testing.T) {
+	cacheFile := filepath.Join(t.TempDir(), "cache", "endpoints.json")
+	cache, err := GetEndpointCache(cacheFile, 3600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := GetEndpointCache(cacheFile, 3600); again != cache {
+		t.Fatal("the endpoint cache of the same file should be shared.")
+	}
+	if _, ok := cache.Get("cn-hangzhou", "ecs", ""); ok {
+		t.Fatal("the empty cache should not return any endpoint.")
+	}
+	if err := cache.Put("cn-hangzhou", "ecs", "", "ecs.cn-hangzhou.aliyuncs.com"); err != nil {
+		t.Fatal(err)
+	}
+	if err := cache.Put("cn-hangzhou", "ecs", "International", "ecs-intl.cn-hangzhou.aliyuncs.com"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Another process reads the same cache file.
+	other := &EndpointCache{CacheFile: cacheFile, TTL: time.Hour}
+	if v, ok := other.Get("cn-hangzhou", "ecs", "Domestic"); !ok || v != "ecs.cn-hangzhou.aliyuncs.com" {
+		t.Fatalf("the cached endpoint should be reused, got %s.", v)
+	}
+	if v, ok := other.Get("cn-hangzhou", "ecs", "international"); !ok || v != "ecs-intl.cn-hangzhou.aliyuncs.com" {
+		t.Fatalf("the cached endpoint should be keyed by the account type, got %s.", v)
+	}
+	if _, ok := other.Get("cn-beijing", "ecs", ""); ok {
+		t.Fatal("the cached endpoint should be keyed by the region.")
+	}
+
+	expired := &EndpointCache{CacheFile: cacheFile, TTL: time.Nanosecond}
+	time.Sleep(time.Millisecond)
+	if _, ok := expired.Get("cn-hangzhou", "ecs", ""); ok {
+		t.Fatal("the expired endpoint should not be returned.")
+	}
+	if endpoints := cache.Endpoints(""); len(endpoints["cn-hangzhou"]) != 1 {
+		t.Fatalf("the cached endpoints of the account type should be returned, got %v.", endpoints)
+	}
+	if matches, _ := filepath.Glob(cacheFile + ".*"); len(matches) != 0 {
+		t.Fatalf("the temporary files should be removed, got %v.", matches)
+	}
+}
+
+func TestWriteEndpointsXml(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteEndpointsXml(&buf, map[string]map[string]string{
+		"cn-hangzhou": {"vpc": "vpc.cn-hangzhou.aliyuncs.com", "ecs": "ecs.cn-hangzhou.aliyuncs.com"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var endpoints Endpoints
+	if err := xml.Unmarshal(buf.Bytes(), &endpoints); err != nil {
+		t.Fatal(err)
+	}
+	if len(endpoints.Endpoint) != 1 || len(endpoints.Endpoint[0].Products.Product) != 2 || endpoints.Endpoint[0].Products.Product[0].ProductName != "ecs" {
+		t.Fatalf("unexpected endpoints %s.", buf.String())
+	}
+
+	// The exported file can be loaded as endpoints.xml.
+	endpointsFile := filepath.Join(t.TempDir(), "endpoints.xml")
+	if err := os.WriteFile(endpointsFile, buf.Bytes(), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(localEndpointPathEnv, endpointsFile)
+	config := &Config{RegionId: "cn-hangzhou", Endpoints: new(sync.Map)}
+	if err := config.loadEndpointFromLocal(); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := config.Endpoints.Load("vpc"); v != "vpc.cn-hangzhou.aliyuncs.com" {
+		t.Fatalf("the exported endpoints should be loaded, got %v.", v)
+	}
+}
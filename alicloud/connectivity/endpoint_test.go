@@ -0,0 +1,53 @@
+package connectivity
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestConvertEndpointForType(t *testing.T) {
+	cases := []struct {
+		productCode, endpoint, endpointType, expected string
+	}{
+		{"ecs", "ecs.cn-hangzhou.aliyuncs.com", EndpointTypePublic, "ecs.cn-hangzhou.aliyuncs.com"},
+		{"ecs", "ecs.cn-hangzhou.aliyuncs.com", "", "ecs.cn-hangzhou.aliyuncs.com"},
+		{"ecs", "ecs.cn-hangzhou.aliyuncs.com", EndpointTypeVpc, "ecs-vpc.cn-hangzhou.aliyuncs.com"},
+		{"ecs", "ecs.cn-hangzhou.aliyuncs.com", EndpointTypeIntranet, "ecs-intranet.cn-hangzhou.aliyuncs.com"},
+		{"ecs", "ecs-vpc.cn-hangzhou.aliyuncs.com", EndpointTypeVpc, "ecs-vpc.cn-hangzhou.aliyuncs.com"},
+		{"bssopenapi", "business.aliyuncs.com", EndpointTypeVpc, "business-vpc.aliyuncs.com"},
+		{"oss", "oss-cn-hangzhou.aliyuncs.com", EndpointTypeVpc, "oss-cn-hangzhou-internal.aliyuncs.com"},
+		{"log", "cn-hangzhou.log.aliyuncs.com", EndpointTypeIntranet, "cn-hangzhou-intranet.log.aliyuncs.com"},
+		{"ram", "ram.aliyuncs.com", EndpointTypeVpc, "ram.vpc-proxy.aliyuncs.com"},
+		{"ram", "ram.aliyuncs.com", EndpointTypeIntranet, "ram-intranet.aliyuncs.com"},
+		{"ecs", "ecs.example.com", EndpointTypeVpc, "ecs.example.com"},
+		{"ecs", "http://127.0.0.1:8080", EndpointTypeVpc, "http://127.0.0.1:8080"},
+	}
+	for _, c := range cases {
+		if got := convertEndpointForType(c.productCode, c.endpoint, "cn-hangzhou", c.endpointType); got != c.expected {
+			t.Errorf("converting the %s endpoint %s got %s, expected %s.", c.endpointType, c.endpoint, got, c.expected)
+		}
+	}
+}
+
+func TestLoadEndpointForType(t *testing.T) {
+	client := &AliyunClient{RegionId: "cn-hangzhou", config: &Config{RegionId: "cn-hangzhou", EndpointType: EndpointTypeVpc, Endpoints: new(sync.Map)}}
+	if err := client.loadEndpoint("tablestore"); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := client.config.Endpoints.Load("tablestore"); v != "tablestore-vpc.cn-hangzhou.aliyuncs.com" {
+		t.Fatalf("the irregular endpoint should be converted to the vpc endpoint, got %v.", v)
+	}
+
+	t.Setenv("ALIBABA_CLOUD_ENDPOINT_CLOUDFW", "cloudfw.example.com")
+	if err := client.loadEndpoint("cloudfw"); err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := client.config.Endpoints.Load("cloudfw"); v != "cloudfw.example.com" {
+		t.Fatalf("the endpoint set by the environment variable should not be converted, got %v.", v)
+	}
+
+	t.Setenv("SLB_ENDPOINT", "slb.example.com")
+	if endpoint := client.loadLegacyEndpoint(SLBCode); endpoint != "slb.example.com" {
+		t.Fatalf("the legacy endpoint set by the environment variable should not be converted, got %s.", endpoint)
+	}
+}
@@ -0,0 +1,92 @@
+package connectivity
+
+import (
+	"context"
+	"log"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// RateLimit is the client side limit of the requests sent to a product.
+type RateLimit struct {
+	// Rate is the number of the requests per second.
+	Rate float64
+	// Burst is the maximum number of the requests sent at once. Default to 1.
+	Burst int
+}
+
+// waitRateLimit blocks until the token bucket of the product allows one more request.
+// The products without rate limit configuration are not limited.
+func (client *AliyunClient) waitRateLimit(productCode string) {
+	if client.config == nil || len(client.config.RateLimits) == 0 {
+		return
+	}
+	productCode = strings.ToLower(ConvertKebabToSnake(productCode))
+	limit, ok := client.config.RateLimits[productCode]
+	if !ok || limit.Rate <= 0 {
+		return
+	}
+	burst := limit.Burst
+	if burst < 1 {
+		burst = 1
+	}
+	v, _ := client.rateLimiters.LoadOrStore(productCode, rate.NewLimiter(rate.Limit(limit.Rate), burst))
+	limiter := v.(*rate.Limiter)
+	start := time.Now()
+	if err := limiter.Wait(context.Background()); err != nil {
+		log.Printf("[WARN] waiting for the %s rate limit got an error: %#v", productCode, err)
+		return
+	}
+	if waited := time.Since(start); waited > time.Second {
+		log.Printf("[DEBUG] the %s request has been delayed %s by the rate limit.", productCode, waited)
+	}
+}
+
+// Backoff is an exponential backoff with jitter. It is shared by the concurrent retry loops,
+// so that all of them slow down together while the API is throttling the requests.
+type Backoff struct {
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+
+	mutex    sync.Mutex
+	attempts int
+	last     time.Time
+}
+
+// ThrottlingBackoff is the backoff applied to the throttling errors.
+var ThrottlingBackoff = &Backoff{BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+
+// Next returns the delay before the next attempt. The attempts are reset once
+// no backoff has been requested for longer than twice the maximum delay.
+func (b *Backoff) Next() time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	if time.Since(b.last) > 2*b.MaxDelay {
+		b.attempts = 0
+	}
+	b.last = time.Now()
+	delay := b.MaxDelay
+	if b.attempts < 30 {
+		delay = b.BaseDelay << uint(b.attempts)
+	}
+	if delay <= 0 || delay > b.MaxDelay {
+		delay = b.MaxDelay
+	}
+	b.attempts++
+	if delay <= 0 {
+		return 0
+	}
+	// Equal jitter: half of the delay is fixed and the other half is random.
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// Wait sleeps for the next backoff delay.
+func (b *Backoff) Wait() {
+	delay := b.Next()
+	log.Printf("[DEBUG] backing off %s before retrying the throttled request.", delay)
+	time.Sleep(delay)
+}
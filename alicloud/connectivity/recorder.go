@@ -0,0 +1,330 @@
+package connectivity
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// RecordModeRecord sends the requests to Alibaba Cloud and records them into the cassette file.
+	RecordModeRecord = "record"
+	// RecordModeReplay replays the requests from the cassette file without any network access.
+	RecordModeReplay = "replay"
+)
+
+const redactedValue = "REDACTED"
+
+// scrubbedFields are the request and response fields removed from the cassette files.
+// The request headers, including the Authorization of the roa requests, are never recorded.
+var scrubbedFields = []string{
+	"Signature", "SignatureNonce", "AccessKeyId", "AccessKeySecret", "SecurityToken", "Password",
+	"PrivateKey", "PrivateKeyBody", "Token",
+}
+
+// volatileFields change between the runs and are not used to match the requests while replaying.
+var volatileFields = []string{"Timestamp", "ClientToken"}
+
+// Interaction is a recorded request and response pair.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+type RecordedRequest struct {
+	Method string     `json:"method"`
+	Host   string     `json:"host"`
+	Path   string     `json:"path"`
+	Query  url.Values `json:"query,omitempty"`
+	Body   string     `json:"body,omitempty"`
+	Action string     `json:"action,omitempty"`
+}
+
+type RecordedResponse struct {
+	StatusCode int               `json:"status_code"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	Body       string            `json:"body"`
+}
+
+// Cassette is the content of a cassette file.
+type Cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Recorder is an http proxy which records the requests sent to Alibaba Cloud into a cassette file,
+// or replays them from the cassette file. The api clients send the plain http requests to it, and
+// it sends them to Alibaba Cloud with https while recording.
+type Recorder struct {
+	Mode         string
+	CassetteFile string
+	ProxyURL     *url.URL
+
+	mutex     sync.Mutex
+	cassette  *Cassette
+	used      map[int]bool
+	transport http.RoundTripper
+	listener  net.Listener
+}
+
+var recorders sync.Map
+
+// GetRecorder returns the recorder of the cassette file, starting it if necessary.
+// The recorders are shared by all of the clients using the same cassette file.
+func GetRecorder(mode, cassetteFile string) (*Recorder, error) {
+	if mode != RecordModeRecord && mode != RecordModeReplay {
+		return nil, fmt.Errorf("invalid record mode %q, expected %q or %q", mode, RecordModeRecord, RecordModeReplay)
+	}
+	if cassetteFile == "" {
+		return nil, fmt.Errorf("the cassette file is required in the %s mode", mode)
+	}
+	key := mode + ":" + cassetteFile
+	if v, ok := recorders.Load(key); ok {
+		return v.(*Recorder), nil
+	}
+	recorder := &Recorder{
+		Mode:         mode,
+		CassetteFile: cassetteFile,
+		cassette:     &Cassette{},
+		used:         make(map[int]bool),
+		transport:    &http.Transport{TLSHandshakeTimeout: 120 * time.Second},
+	}
+	if mode == RecordModeReplay {
+		data, err := os.ReadFile(cassetteFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading the cassette file %s got an error: %#v", cassetteFile, err)
+		}
+		if err := json.Unmarshal(data, recorder.cassette); err != nil {
+			return nil, fmt.Errorf("parsing the cassette file %s got an error: %#v", cassetteFile, err)
+		}
+	}
+	if err := recorder.start(); err != nil {
+		return nil, err
+	}
+	if v, loaded := recorders.LoadOrStore(key, recorder); loaded {
+		recorder.listener.Close()
+		return v.(*Recorder), nil
+	}
+	log.Printf("[INFO] %s the api requests with the cassette file %s via %s.", mode, cassetteFile, recorder.ProxyURL)
+	return recorder, nil
+}
+
+func (r *Recorder) start() error {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return fmt.Errorf("starting the recorder got an error: %#v", err)
+	}
+	r.listener = listener
+	r.ProxyURL = &url.URL{Scheme: "http", Host: listener.Addr().String()}
+	go http.Serve(listener, r)
+	return nil
+}
+
+// ServeHTTP handles a proxied request.
+func (r *Recorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	host := req.URL.Host
+	if host == "" {
+		host = req.Host
+	}
+	recorded := RecordedRequest{
+		Method: req.Method,
+		Host:   host,
+		Path:   req.URL.Path,
+		Query:  scrubValues(req.URL.Query()),
+		Body:   scrubBody(req.Header.Get("Content-Type"), body),
+	}
+	recorded.Action = recorded.Query.Get("Action")
+	if recorded.Action == "" {
+		recorded.Action = req.Header.Get("x-acs-action")
+	}
+
+	var response *RecordedResponse
+	if r.Mode == RecordModeReplay {
+		response = r.replay(recorded)
+	} else {
+		response, err = r.record(req, body, recorded)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+	}
+	for k, v := range response.Headers {
+		w.Header().Set(k, v)
+	}
+	w.WriteHeader(response.StatusCode)
+	w.Write([]byte(response.Body))
+}
+
+func (r *Recorder) record(req *http.Request, body []byte, recorded RecordedRequest) (*RecordedResponse, error) {
+	upstream := *req.URL
+	upstream.Scheme = "https"
+	upstream.Host = recorded.Host
+	outReq, err := http.NewRequest(req.Method, upstream.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	outReq.Header = req.Header.Clone()
+	outReq.Header.Del("Proxy-Connection")
+	resp, err := r.transport.RoundTrip(outReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	response := &RecordedResponse{
+		StatusCode: resp.StatusCode,
+		Headers:    make(map[string]string),
+	}
+	for _, k := range []string{"Content-Type", "X-Acs-Request-Id"} {
+		if v := resp.Header.Get(k); v != "" {
+			response.Headers[k] = v
+		}
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	// The real response is returned to the client, and the scrubbed one is saved.
+	scrubbed := *response
+	scrubbed.Body = scrubBody(resp.Header.Get("Content-Type"), respBody)
+	r.cassette.Interactions = append(r.cassette.Interactions, &Interaction{Request: recorded, Response: scrubbed})
+	if err := r.save(); err != nil {
+		log.Printf("[ERROR] saving the cassette file %s got an error: %#v", r.CassetteFile, err)
+	}
+	response.Body = string(respBody)
+	return response, nil
+}
+
+// replay returns the first unused interaction matching the request. The requests are matched by the
+// method, host, path and all of the parameters except the volatile ones, and then only by the api action,
+// so that the requests with the generated values, like the random resource names, can be replayed in order.
+func (r *Recorder) replay(recorded RecordedRequest) *RecordedResponse {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	key := matchKey(recorded, true)
+	index := -1
+	for i, interaction := range r.cassette.Interactions {
+		if !r.used[i] && matchKey(interaction.Request, true) == key {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		key = matchKey(recorded, false)
+		for i, interaction := range r.cassette.Interactions {
+			if !r.used[i] && matchKey(interaction.Request, false) == key {
+				index = i
+				break
+			}
+		}
+	}
+	if index < 0 {
+		log.Printf("[ERROR] no interaction in the cassette file %s matches the request %s %s%s (Action: %s).", r.CassetteFile, recorded.Method, recorded.Host, recorded.Path, recorded.Action)
+		body, _ := json.Marshal(map[string]interface{}{
+			"Code":    "CassetteInteractionNotFound",
+			"Message": fmt.Sprintf("no recorded interaction matches the request %s %s%s (Action: %s)", recorded.Method, recorded.Host, recorded.Path, recorded.Action),
+		})
+		return &RecordedResponse{
+			StatusCode: http.StatusNotFound,
+			Headers:    map[string]string{"Content-Type": "application/json"},
+			Body:       string(body),
+		}
+	}
+	r.used[index] = true
+	response := r.cassette.Interactions[index].Response
+	return &response
+}
+
+func (r *Recorder) save() error {
+	data, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.CassetteFile), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.CassetteFile, data, 0644)
+}
+
+func matchKey(request RecordedRequest, withParameters bool) string {
+	key := strings.Join([]string{request.Method, request.Host, request.Path, request.Action}, " ")
+	if !withParameters {
+		return key
+	}
+	query := url.Values{}
+	for k, v := range request.Query {
+		if !isField(k, volatileFields) && !isField(k, scrubbedFields) {
+			query[k] = v
+		}
+	}
+	body := request.Body
+	if values, err := url.ParseQuery(body); err == nil && !strings.HasPrefix(strings.TrimSpace(body), "{") {
+		for k := range values {
+			if isField(k, volatileFields) {
+				values.Del(k)
+			}
+		}
+		body = values.Encode()
+	}
+	return key + " " + query.Encode() + " " + body
+}
+
+func isField(key string, fields []string) bool {
+	for _, field := range fields {
+		if strings.EqualFold(key, field) {
+			return true
+		}
+	}
+	return false
+}
+
+func scrubValues(values url.Values) url.Values {
+	result := url.Values{}
+	for k, v := range values {
+		if isField(k, scrubbedFields) {
+			result.Set(k, redactedValue)
+			continue
+		}
+		result[k] = v
+	}
+	return result
+}
+
+var scrubbedJsonFieldsRegex = func() *regexp.Regexp {
+	fields := make([]string, 0, len(scrubbedFields))
+	for _, field := range scrubbedFields {
+		fields = append(fields, regexp.QuoteMeta(field))
+	}
+	sort.Strings(fields)
+	return regexp.MustCompile(`(?i)("(?:` + strings.Join(fields, "|") + `)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+}()
+
+func scrubBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	if strings.Contains(contentType, "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			return scrubValues(values).Encode()
+		}
+	}
+	return scrubbedJsonFieldsRegex.ReplaceAllString(string(body), `$1"`+redactedValue+`"`)
+}
@@ -0,0 +1,71 @@
+package connectivity
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestScrubBody(t *testing.T) {
+	form := scrubBody("application/x-www-form-urlencoded", []byte("InstanceName=tf-test&Password=Test12345"))
+	if form != "InstanceName=tf-test&Password=REDACTED" {
+		t.Fatalf("the password in the form should be scrubbed, got %s.", form)
+	}
+	body := scrubBody("application/json", []byte(`{"AccessKey":{"AccessKeyId":"LTAI","AccessKeySecret":"secret","Status":"Active"}}`))
+	if strings.Contains(body, "LTAI") || strings.Contains(body, "secret") || !strings.Contains(body, `"Status":"Active"`) {
+		t.Fatalf("the credentials in the body should be scrubbed, got %s.", body)
+	}
+}
+
+func TestRecorderReplay(t *testing.T) {
+	cassetteFile := filepath.Join(t.TempDir(), "cassette.json")
+	cassette := Cassette{Interactions: []*Interaction{
+		{
+			Request: RecordedRequest{
+				Method: "POST", Host: "vpc.aliyuncs.com", Path: "/", Action: "DescribeVpcs",
+				Query: url.Values{"Action": {"DescribeVpcs"}, "VpcId": {"vpc-first"}, "Timestamp": {"2024-01-01T00:00:00Z"}},
+			},
+			Response: RecordedResponse{StatusCode: 200, Body: `{"RequestId":"first"}`},
+		},
+		{
+			Request: RecordedRequest{
+				Method: "POST", Host: "vpc.aliyuncs.com", Path: "/", Action: "DescribeVpcs",
+				Query: url.Values{"Action": {"DescribeVpcs"}, "VpcId": {"vpc-second"}},
+			},
+			Response: RecordedResponse{StatusCode: 200, Body: `{"RequestId":"second"}`},
+		},
+	}}
+	data, _ := json.Marshal(cassette)
+	if err := os.WriteFile(cassetteFile, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	recorder, err := GetRecorder(RecordModeReplay, cassetteFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpClient := &http.Client{Transport: &http.Transport{Proxy: http.ProxyURL(recorder.ProxyURL)}}
+	send := func(query string) (int, string) {
+		resp, err := httpClient.Post("http://vpc.aliyuncs.com/?"+query, "application/x-www-form-urlencoded", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return resp.StatusCode, string(body)
+	}
+
+	if _, body := send("Action=DescribeVpcs&VpcId=vpc-second&Timestamp=2025-01-01T00:00:00Z&Signature=xxx"); body != `{"RequestId":"second"}` {
+		t.Fatalf("the request should match the interaction with the same parameters, got %s.", body)
+	}
+	if _, body := send("Action=DescribeVpcs&VpcId=vpc-random"); body != `{"RequestId":"first"}` {
+		t.Fatalf("the request should fall back to the unused interaction with the same action, got %s.", body)
+	}
+	if code, body := send("Action=DescribeVpcs&VpcId=vpc-first"); code != http.StatusNotFound || !strings.Contains(body, "CassetteInteractionNotFound") {
+		t.Fatalf("the request should not match the used interactions, got %d %s.", code, body)
+	}
+}
@@ -0,0 +1,48 @@
+package connectivity
+
+import (
+	"strings"
+	"sync"
+)
+
+// WithRegion returns the client of the region, which is derived from the client of the provider and cached.
+// It shares the credentials, the endpoint cache, the retry policy, the recorder and the tracer of the provider.
+// The endpoints set in the provider are only applied to the region of the provider.
+func (client *AliyunClient) WithRegion(regionId string) (*AliyunClient, error) {
+	regionId = strings.TrimSpace(regionId)
+	if client.parent != nil {
+		return client.parent.WithRegion(regionId)
+	}
+	if regionId == "" || regionId == client.RegionId {
+		return client, nil
+	}
+	if v, ok := client.regionalClients.Load(regionId); ok {
+		return v.(*AliyunClient), nil
+	}
+	client.regionalClientsMutex.Lock()
+	defer client.regionalClientsMutex.Unlock()
+	if v, ok := client.regionalClients.Load(regionId); ok {
+		return v.(*AliyunClient), nil
+	}
+
+	config := *client.config
+	config.RegionId = regionId
+	config.Region = Region(regionId)
+	if !config.SkipRegionValidation {
+		if err := config.validateRegion(); err != nil {
+			return nil, err
+		}
+	}
+	var endpoints sync.Map
+	config.Endpoints = &endpoints
+	regional, err := config.newClient()
+	if err != nil {
+		return nil, err
+	}
+	regional.parent = client
+	client.accountIdMutex.RLock()
+	regional.accountId = client.accountId
+	client.accountIdMutex.RUnlock()
+	client.regionalClients.Store(regionId, regional)
+	return regional, nil
+}
@@ -0,0 +1,50 @@
+package connectivity
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestAliyunClientWithRegion(t *testing.T) {
+	var endpoints sync.Map
+	endpoints.Store("ecs", "ecs.cn-hangzhou.aliyuncs.com")
+	config := &Config{
+		AccessKey:   "ak",
+		SecretKey:   "sk",
+		RegionId:    "cn-hangzhou",
+		Region:      Hangzhou,
+		Protocol:    "HTTPS",
+		AccountType: "Domestic",
+		Endpoints:   &endpoints,
+	}
+	client, err := config.newClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	regional, err := client.WithRegion("cn-beijing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if regional.RegionId != "cn-beijing" || regional.config.RegionId != "cn-beijing" || client.RegionId != "cn-hangzhou" {
+		t.Errorf("the regional client is in %s, and the provider client is in %s.", regional.RegionId, client.RegionId)
+	}
+	if regional.config.AccessKey != "ak" || regional.config.AccountType != "Domestic" {
+		t.Errorf("the regional client should share the credentials and account type, got %s and %s.", regional.config.AccessKey, regional.config.AccountType)
+	}
+	if _, ok := regional.config.Endpoints.Load("ecs"); ok {
+		t.Error("the endpoints of the provider region should not be applied to the regional client.")
+	}
+	if v, _ := client.WithRegion("cn-beijing"); v != regional {
+		t.Error("the regional client should be cached.")
+	}
+	if v, _ := regional.WithRegion("cn-hangzhou"); v != client {
+		t.Error("the client of the provider region should be returned by the regional client.")
+	}
+	if v, _ := client.WithRegion(""); v != client {
+		t.Error("the empty region should return the client of the provider region.")
+	}
+	if _, err := client.WithRegion("cn-invalid"); err == nil {
+		t.Error("the invalid region should be rejected.")
+	}
+}
@@ -0,0 +1,85 @@
+package connectivity
+
+import (
+	"log"
+	"math/rand"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+// RetryPolicy is the client side retry of the failed requests. The throttling errors, the 5xx errors and
+// the extra retryable error codes of the product are retried with the exponential backoff.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of the attempts of a request, including the first one.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry, and it doubles for every later retry.
+	BaseDelay time.Duration
+	// MaxDelay is the maximum delay before a retry.
+	MaxDelay time.Duration
+	// Jitter is the randomized fraction of the delay, from 0 to 1.
+	Jitter float64
+	// RetryableErrorCodes is the extra retryable error codes keyed by the product code.
+	RetryableErrorCodes map[string][]string
+}
+
+var retryableErrorCodeRegex = regexp.MustCompile("Throttling|^ServiceUnavailable$")
+
+// IsRetryable returns whether the error of the product request should be retried.
+func (p *RetryPolicy) IsRetryable(productCode string, err error) bool {
+	if p == nil || err == nil {
+		return false
+	}
+	e, ok := err.(*tea.SDKError)
+	if !ok {
+		return false
+	}
+	code := tea.StringValue(e.Code)
+	if retryableErrorCodeRegex.MatchString(code) || tea.IntValue(e.StatusCode) >= 500 {
+		return true
+	}
+	productCode = strings.ToLower(ConvertKebabToSnake(productCode))
+	for _, c := range p.RetryableErrorCodes[productCode] {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Delay returns the delay before the retry. The attempt is the number of the failed attempts.
+func (p *RetryPolicy) Delay(attempt int) time.Duration {
+	delay := p.MaxDelay
+	if attempt < 30 {
+		delay = p.BaseDelay << uint(attempt-1)
+	}
+	if delay <= 0 || delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	jitter := time.Duration(float64(delay) * p.Jitter)
+	if jitter <= 0 {
+		return delay
+	}
+	return delay - jitter + time.Duration(rand.Int63n(int64(jitter)+1))
+}
+
+// doWithRetryPolicy sends the request until it succeeds, the error is not retryable or the attempts
+// run out. The request is sent once if the retry policy is not configured.
+func (client *AliyunClient) doWithRetryPolicy(productCode, apiName string, do func() (map[string]interface{}, error)) (map[string]interface{}, error) {
+	policy := client.config.RetryPolicy
+	for attempt := 1; ; attempt++ {
+		client.waitRateLimit(productCode)
+		response, err := do()
+		if policy == nil || attempt >= policy.MaxAttempts || !policy.IsRetryable(productCode, err) {
+			return response, err
+		}
+		delay := policy.Delay(attempt)
+		log.Printf("[DEBUG] retrying the %s %s request in %s after the attempt %d got an error: %v", productCode, apiName, delay, attempt, err)
+		time.Sleep(delay)
+	}
+}
@@ -0,0 +1,87 @@
+package connectivity
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+func TestRetryPolicyIsRetryable(t *testing.T) {
+	policy := &RetryPolicy{
+		MaxAttempts:         3,
+		RetryableErrorCodes: map[string][]string{"ecs": {"IncorrectInstanceStatus"}},
+	}
+	cases := []struct {
+		productCode string
+		err         error
+		expected    bool
+	}{
+		{"ecs", tea.NewSDKError(map[string]interface{}{"code": "Throttling.User", "statusCode": 400}), true},
+		{"ecs", tea.NewSDKError(map[string]interface{}{"code": "ServiceUnavailable", "statusCode": 503}), true},
+		{"ecs", tea.NewSDKError(map[string]interface{}{"code": "InternalError", "statusCode": 500}), true},
+		{"ecs", tea.NewSDKError(map[string]interface{}{"code": "IncorrectInstanceStatus", "statusCode": 403}), true},
+		{"vpc", tea.NewSDKError(map[string]interface{}{"code": "IncorrectInstanceStatus", "statusCode": 403}), false},
+		{"ecs", tea.NewSDKError(map[string]interface{}{"code": "InvalidParameter", "statusCode": 400}), false},
+		{"ecs", fmt.Errorf("unknown error"), false},
+		{"ecs", nil, false},
+	}
+	for _, c := range cases {
+		if got := policy.IsRetryable(c.productCode, c.err); got != c.expected {
+			t.Errorf("retrying the %s error %v got %t, expected %t.", c.productCode, c.err, got, c.expected)
+		}
+	}
+	if (*RetryPolicy)(nil).IsRetryable("ecs", tea.NewSDKError(map[string]interface{}{"code": "Throttling.User"})) {
+		t.Error("the nil retry policy should not retry any error.")
+	}
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := &RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	for attempt, expected := range map[int]time.Duration{1: 100 * time.Millisecond, 2: 200 * time.Millisecond, 4: 800 * time.Millisecond, 5: time.Second, 64: time.Second} {
+		if got := policy.Delay(attempt); got != expected {
+			t.Errorf("the delay of the attempt %d got %s, expected %s.", attempt, got, expected)
+		}
+	}
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		if got := policy.Delay(2); got < 100*time.Millisecond || got > 200*time.Millisecond {
+			t.Fatalf("the jittered delay got %s, expected between 100ms and 200ms.", got)
+		}
+	}
+}
+
+func TestDoWithRetryPolicy(t *testing.T) {
+	client := &AliyunClient{config: &Config{RetryPolicy: &RetryPolicy{MaxAttempts: 3}}}
+	attempts := 0
+	_, err := client.doWithRetryPolicy("ecs", "DescribeInstances", func() (map[string]interface{}, error) {
+		attempts++
+		return nil, tea.NewSDKError(map[string]interface{}{"code": "Throttling.User", "statusCode": 400})
+	})
+	if err == nil || attempts != 3 {
+		t.Fatalf("the request should be sent 3 times, got %d.", attempts)
+	}
+
+	attempts = 0
+	response, err := client.doWithRetryPolicy("ecs", "DescribeInstances", func() (map[string]interface{}, error) {
+		attempts++
+		if attempts == 1 {
+			return nil, tea.NewSDKError(map[string]interface{}{"code": "ServiceUnavailable", "statusCode": 503})
+		}
+		return map[string]interface{}{"RequestId": "succeeded"}, nil
+	})
+	if err != nil || attempts != 2 || response["RequestId"] != "succeeded" {
+		t.Fatalf("the request should succeed in the second attempt, got %d attempts and error %v.", attempts, err)
+	}
+
+	client.config.RetryPolicy = nil
+	attempts = 0
+	client.doWithRetryPolicy("ecs", "DescribeInstances", func() (map[string]interface{}, error) {
+		attempts++
+		return nil, tea.NewSDKError(map[string]interface{}{"code": "Throttling.User", "statusCode": 400})
+	})
+	if attempts != 1 {
+		t.Fatalf("the request should be sent once without the retry policy, got %d.", attempts)
+	}
+}
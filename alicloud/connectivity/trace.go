@@ -0,0 +1,184 @@
+package connectivity
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+// TraceRecord is a traced api call. It is written as one json line into the trace file.
+type TraceRecord struct {
+	Time       string                 `json:"time"`
+	TraceId    string                 `json:"trace_id,omitempty"`
+	Product    string                 `json:"product"`
+	Action     string                 `json:"action"`
+	Version    string                 `json:"version"`
+	Method     string                 `json:"method,omitempty"`
+	Endpoint   string                 `json:"endpoint"`
+	RequestId  string                 `json:"request_id,omitempty"`
+	LatencyMs  int64                  `json:"latency_ms"`
+	RetryCount int                    `json:"retry_count"`
+	StatusCode int                    `json:"status_code,omitempty"`
+	ErrorCode  string                 `json:"error_code,omitempty"`
+	Error      string                 `json:"error,omitempty"`
+	Request    map[string]interface{} `json:"request,omitempty"`
+}
+
+// Tracer writes the api calls into the trace file. The request parameters are redacted before written,
+// and the responses are never written.
+type Tracer struct {
+	TraceFile string
+
+	mutex    sync.Mutex
+	file     *os.File
+	failures map[string]int
+}
+
+var tracers sync.Map
+
+// GetTracer returns the tracer of the trace file, opening the file in the append mode if necessary.
+// The tracers are shared by all of the clients using the same trace file.
+func GetTracer(traceFile string) (*Tracer, error) {
+	if v, ok := tracers.Load(traceFile); ok {
+		return v.(*Tracer), nil
+	}
+	file, err := os.OpenFile(traceFile, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("opening the trace file %s got an error: %#v", traceFile, err)
+	}
+	tracer := &Tracer{
+		TraceFile: traceFile,
+		file:      file,
+		failures:  make(map[string]int),
+	}
+	if v, loaded := tracers.LoadOrStore(traceFile, tracer); loaded {
+		file.Close()
+		return v.(*Tracer), nil
+	}
+	return tracer, nil
+}
+
+// Trace writes the api call. The retry count is the number of the failed calls of the same action with
+// the same parameters just before this one, as the retries are done by the callers of the api.
+func (t *Tracer) Trace(record *TraceRecord, start time.Time, response map[string]interface{}, err error) {
+	if t == nil {
+		return
+	}
+	record.Time = start.UTC().Format(time.RFC3339Nano)
+	record.LatencyMs = time.Since(start).Milliseconds()
+	if v, ok := response["RequestId"]; ok && v != nil {
+		record.RequestId = fmt.Sprint(v)
+	}
+	if err != nil {
+		record.Error = err.Error()
+		if e, ok := err.(*tea.SDKError); ok {
+			record.ErrorCode = tea.StringValue(e.Code)
+			record.StatusCode = tea.IntValue(e.StatusCode)
+			if record.RequestId == "" {
+				record.RequestId = requestIdOfSDKError(e)
+			}
+		}
+	}
+
+	key := traceKey(record)
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	record.RetryCount = t.failures[key]
+	if err != nil {
+		t.failures[key]++
+	} else {
+		delete(t.failures, key)
+	}
+	data, e := json.Marshal(record)
+	if e != nil {
+		log.Printf("[ERROR] encoding the trace record of %s got an error: %#v", record.Action, e)
+		return
+	}
+	if _, e := t.file.Write(append(data, '\n')); e != nil {
+		log.Printf("[ERROR] writing the trace file %s got an error: %#v", t.TraceFile, e)
+	}
+}
+
+func traceKey(record *TraceRecord) string {
+	request, _ := json.Marshal(record.Request)
+	sum := sha256.Sum256([]byte(strings.Join([]string{record.Product, record.Endpoint, record.Version, record.Action, string(request)}, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+func requestIdOfSDKError(e *tea.SDKError) string {
+	data := make(map[string]interface{})
+	if json.Unmarshal([]byte(tea.StringValue(e.Data)), &data) != nil {
+		return ""
+	}
+	for _, key := range []string{"RequestId", "requestId"} {
+		if v, ok := data[key]; ok && v != nil {
+			return fmt.Sprint(v)
+		}
+	}
+	return ""
+}
+
+// RedactFields returns a copy of the api parameters, where the values of the secret fields,
+// like Password, AccessKeySecret and PrivateKey, are replaced. The nested maps and lists are redacted too.
+func RedactFields(parameters map[string]interface{}) map[string]interface{} {
+	if parameters == nil {
+		return nil
+	}
+	result := make(map[string]interface{}, len(parameters))
+	for k, v := range parameters {
+		if isRedactedField(k) {
+			result[k] = redactedValue
+			continue
+		}
+		result[k] = redactValue(v)
+	}
+	return result
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return RedactFields(v)
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = redactValue(item)
+		}
+		return result
+	case []map[string]interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = RedactFields(item)
+		}
+		return result
+	}
+	return value
+}
+
+// isRedactedField reports whether the field is secret, including the flattened ones like
+// LoginProfile.Password and Tag.1.PrivateKey.
+func isRedactedField(key string) bool {
+	if i := strings.LastIndex(key, "."); i >= 0 {
+		key = key[i+1:]
+	}
+	return isField(key, scrubbedFields)
+}
+
+func mergeParameters(query, body map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(query)+len(body))
+	for k, v := range query {
+		result[k] = v
+	}
+	for k, v := range body {
+		result[k] = v
+	}
+	return RedactFields(result)
+}
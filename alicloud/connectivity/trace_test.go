@@ -0,0 +1,92 @@
+package connectivity
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+func TestRedactFields(t *testing.T) {
+	parameters := map[string]interface{}{
+		"InstanceName":           "tf-test",
+		"Password":               "Test12345",
+		"LoginProfile.Password":  "Test12345",
+		"AccessKey":              map[string]interface{}{"AccessKeyId": "LTAI", "AccessKeySecret": "secret"},
+		"Certificates":           []interface{}{map[string]interface{}{"PrivateKey": "key", "Name": "cert"}},
+		"SystemDisk.PrivateKey":  "key",
+		"SystemDisk.Description": "disk",
+	}
+	result := RedactFields(parameters)
+	if result["InstanceName"] != "tf-test" || result["SystemDisk.Description"] != "disk" {
+		t.Fatalf("the fields which are not secret should be kept, got %v.", result)
+	}
+	if result["Password"] != redactedValue || result["LoginProfile.Password"] != redactedValue || result["SystemDisk.PrivateKey"] != redactedValue {
+		t.Fatalf("the secret fields should be redacted, got %v.", result)
+	}
+	accessKey := result["AccessKey"].(map[string]interface{})
+	if accessKey["AccessKeyId"] != redactedValue || accessKey["AccessKeySecret"] != redactedValue {
+		t.Fatalf("the nested secret fields should be redacted, got %v.", accessKey)
+	}
+	certificate := result["Certificates"].([]interface{})[0].(map[string]interface{})
+	if certificate["PrivateKey"] != redactedValue || certificate["Name"] != "cert" {
+		t.Fatalf("the secret fields in the list should be redacted, got %v.", certificate)
+	}
+	if parameters["Password"] != "Test12345" {
+		t.Fatal("the parameters should not be changed.")
+	}
+}
+
+func TestTracerTrace(t *testing.T) {
+	traceFile := filepath.Join(t.TempDir(), "trace.json")
+	tracer, err := GetTracer(traceFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	request := mergeParameters(map[string]interface{}{"RegionId": "cn-hangzhou"}, map[string]interface{}{"Password": "Test12345"})
+	throttling := tea.NewSDKError(map[string]interface{}{
+		"statusCode": 400,
+		"code":       "Throttling.User",
+		"message":    "Request was denied due to user flow control.",
+		"data":       map[string]interface{}{"RequestId": "failed"},
+	})
+	for _, err := range []error{throttling, throttling, nil} {
+		record := &TraceRecord{Product: "ecs", Action: "CreateInstance", Version: "2014-05-26", Endpoint: "ecs.aliyuncs.com", Request: request}
+		var response map[string]interface{}
+		if err == nil {
+			response = map[string]interface{}{"RequestId": "succeeded"}
+		}
+		tracer.Trace(record, time.Now(), response, err)
+	}
+
+	file, err := os.Open(traceFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	records := make([]TraceRecord, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		record := TraceRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("every line should be a json record, got %s.", scanner.Text())
+		}
+		records = append(records, record)
+	}
+	if len(records) != 3 {
+		t.Fatalf("expected 3 records, got %d.", len(records))
+	}
+	if records[0].ErrorCode != "Throttling.User" || records[0].StatusCode != 400 || records[0].RequestId != "failed" || records[0].RetryCount != 0 {
+		t.Fatalf("unexpected record of the failed call %#v.", records[0])
+	}
+	if records[2].ErrorCode != "" || records[2].RequestId != "succeeded" || records[2].RetryCount != 2 {
+		t.Fatalf("unexpected record of the retried call %#v.", records[2])
+	}
+	if records[2].Request["Password"] != redactedValue || records[2].Request["RegionId"] != "cn-hangzhou" {
+		t.Fatalf("the request should be redacted, got %v.", records[2].Request)
+	}
+}
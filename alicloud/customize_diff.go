@@ -0,0 +1,194 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/PaesslerAG/jsonpath"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The CustomizeDiff functions below check the common invariants between the resource arguments at plan time,
+// so the configuration mistakes are reported before any resource is created.
+//
+// The arguments read by ResourceDiff include the values kept in the state. To avoid reporting the values
+// which were not set by the configuration, like the computed ones, an argument counts as set only when it
+// has a non-zero value while the resource is being created, or when it is changed to a non-zero value.
+
+// customizeDiffAll runs all of the CustomizeDiff functions and returns all of their errors.
+func customizeDiffAll(funcs ...schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return customdiff.All(funcs...)
+}
+
+// customizeDiffOnCreate runs the CustomizeDiff function only when the resource is being created.
+func customizeDiffOnCreate(f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return customdiff.If(func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+		return diff.Id() == ""
+	}, f)
+}
+
+// customizeDiffConflictsWhenValue reports an error if any of the conflicting arguments is set while the
+// argument key has one of the values.
+func customizeDiffConflictsWhenValue(key string, values []string, conflicts ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		value, ok := customizeDiffValue(diff, key)
+		if !ok || !customizeDiffValueIn(value, values) {
+			return nil
+		}
+		for _, conflict := range conflicts {
+			if customizeDiffIsSet(diff, conflict) {
+				return fmt.Errorf("%q can not be set when %q is %q", conflict, key, value)
+			}
+		}
+		return nil
+	}
+}
+
+// customizeDiffRequiredWhenValue reports an error if any of the required arguments is not set while the
+// argument key has one of the values.
+func customizeDiffRequiredWhenValue(key string, values []string, required ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		value, ok := customizeDiffValue(diff, key)
+		if !ok || !customizeDiffValueIn(value, values) {
+			return nil
+		}
+		for _, r := range required {
+			if !diff.NewValueKnown(r) {
+				continue
+			}
+			if _, ok := diff.GetOk(r); !ok {
+				return fmt.Errorf("%q is required when %q is %q", r, key, value)
+			}
+		}
+		return nil
+	}
+}
+
+// customizeDiffOnlyWhenValue reports an error if any of the arguments is set while the argument key does
+// not have one of the values. The unset argument key has an empty value.
+func customizeDiffOnlyWhenValue(key string, values []string, keys ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		value, ok := customizeDiffValue(diff, key)
+		if !ok || customizeDiffValueIn(value, values) {
+			return nil
+		}
+		for _, k := range keys {
+			if customizeDiffIsSet(diff, k) {
+				return fmt.Errorf("%q can only be set when %q is one of %s, got %q", k, key, strings.Join(values, ", "), value)
+			}
+		}
+		return nil
+	}
+}
+
+// customizeDiffPeriodOnlyWithPrePaid reports an error if the subscription arguments, like period and
+// auto_renew_period, are set while the charge type argument is not PrePaid.
+func customizeDiffPeriodOnlyWithPrePaid(chargeTypeKey, prePaid string, keys ...string) schema.CustomizeDiffFunc {
+	return customizeDiffOnCreate(customizeDiffOnlyWhenValue(chargeTypeKey, []string{prePaid}, keys...))
+}
+
+// customizeDiffCidrWithinCidrs reports an error if the CIDR block argument key is not within any of the
+// CIDR blocks returned by cidrsFunc. The check is skipped if cidrsFunc returns no CIDR block.
+func customizeDiffCidrWithinCidrs(key string, cidrsFunc func(diff *schema.ResourceDiff, meta interface{}) ([]string, error)) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		value, ok := customizeDiffValue(diff, key)
+		if !ok || value == "" {
+			return nil
+		}
+		_, inner, err := net.ParseCIDR(value)
+		if err != nil {
+			return fmt.Errorf("%q must be a valid CIDR block, got %q: %v", key, value, err)
+		}
+		cidrs, err := cidrsFunc(diff, meta)
+		if err != nil {
+			return err
+		}
+		if len(cidrs) == 0 {
+			return nil
+		}
+		for _, cidr := range cidrs {
+			if cidrContains(cidr, inner) {
+				return nil
+			}
+		}
+		return fmt.Errorf("%q %s must be within one of the CIDR blocks %s", key, value, strings.Join(cidrs, ", "))
+	}
+}
+
+// customizeDiffVpcCidrBlocks returns the primary and secondary CIDR blocks of the VPC set by the argument
+// vpcIdKey. It returns no CIDR block if the VPC is unknown yet or does not exist.
+func customizeDiffVpcCidrBlocks(vpcIdKey string) func(diff *schema.ResourceDiff, meta interface{}) ([]string, error) {
+	return func(diff *schema.ResourceDiff, meta interface{}) ([]string, error) {
+		vpcId, ok := customizeDiffValue(diff, vpcIdKey)
+		if !ok || vpcId == "" {
+			return nil, nil
+		}
+		vpcServiceV2 := VpcServiceV2{meta.(*connectivity.AliyunClient)}
+		object, err := vpcServiceV2.DescribeVpcVpc(vpcId)
+		if err != nil {
+			if NotFoundError(err) {
+				return nil, nil
+			}
+			return nil, WrapError(err)
+		}
+		cidrs := make([]string, 0)
+		if v := fmt.Sprint(object["CidrBlock"]); object["CidrBlock"] != nil && v != "" {
+			cidrs = append(cidrs, v)
+		}
+		secondaryCidrBlocks, _ := jsonpath.Get("$.SecondaryCidrBlocks.SecondaryCidrBlock", object)
+		if v, ok := secondaryCidrBlocks.([]interface{}); ok {
+			for _, item := range v {
+				cidrs = append(cidrs, fmt.Sprint(item))
+			}
+		}
+		return cidrs, nil
+	}
+}
+
+// cidrContains reports whether the CIDR block contains the inner network.
+func cidrContains(cidr string, inner *net.IPNet) bool {
+	_, outer, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return false
+	}
+	outerOnes, outerBits := outer.Mask.Size()
+	innerOnes, innerBits := inner.Mask.Size()
+	return outerBits == innerBits && outerOnes <= innerOnes && outer.Contains(inner.IP)
+}
+
+// customizeDiffValue returns the planned value of the argument as a string. It returns false if the value
+// is unknown yet.
+func customizeDiffValue(diff *schema.ResourceDiff, key string) (string, bool) {
+	if !diff.NewValueKnown(key) {
+		return "", false
+	}
+	v, ok := diff.GetOk(key)
+	if !ok {
+		return "", true
+	}
+	return fmt.Sprint(v), true
+}
+
+func customizeDiffValueIn(value string, values []string) bool {
+	for _, v := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+// customizeDiffIsSet reports whether the argument is set by the configuration. See the comment at the top.
+func customizeDiffIsSet(diff *schema.ResourceDiff, key string) bool {
+	if !diff.NewValueKnown(key) {
+		return false
+	}
+	if _, ok := diff.GetOk(key); !ok {
+		return false
+	}
+	return diff.Id() == "" || diff.HasChange(key)
+}
@@ -0,0 +1,76 @@
+package alicloud
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func testCustomizeDiffResource(customizeDiff schema.CustomizeDiffFunc) *schema.Resource {
+	return &schema.Resource{
+		CustomizeDiff: customizeDiff,
+		Schema: map[string]*schema.Schema{
+			"instance_charge_type": {Type: schema.TypeString, Optional: true},
+			"period":               {Type: schema.TypeInt, Optional: true},
+			"spot_strategy":        {Type: schema.TypeString, Optional: true},
+			"spot_price_limit":     {Type: schema.TypeFloat, Optional: true},
+			"cidr_block":           {Type: schema.TypeString, Optional: true},
+		},
+	}
+}
+
+func testCustomizeDiff(r *schema.Resource, raw map[string]interface{}) error {
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+	return err
+}
+
+func TestUnitCustomizeDiffPeriodOnlyWithPrePaid(t *testing.T) {
+	r := testCustomizeDiffResource(customizeDiffPeriodOnlyWithPrePaid("instance_charge_type", "PrePaid", "period"))
+	if err := testCustomizeDiff(r, map[string]interface{}{"instance_charge_type": "PrePaid", "period": 1}); err != nil {
+		t.Fatalf("the period should be allowed with PrePaid, got %v.", err)
+	}
+	if err := testCustomizeDiff(r, map[string]interface{}{"instance_charge_type": "PostPaid"}); err != nil {
+		t.Fatalf("PostPaid without the period should be allowed, got %v.", err)
+	}
+	err := testCustomizeDiff(r, map[string]interface{}{"instance_charge_type": "PostPaid", "period": 1})
+	if err == nil || !strings.Contains(err.Error(), `"period" can only be set when "instance_charge_type" is one of PrePaid`) {
+		t.Fatalf("the period should be rejected with PostPaid, got %v.", err)
+	}
+}
+
+func TestUnitCustomizeDiffRequiredAndConflictsWhenValue(t *testing.T) {
+	r := testCustomizeDiffResource(customizeDiffAll(
+		customizeDiffRequiredWhenValue("spot_strategy", []string{"SpotWithPriceLimit"}, "spot_price_limit"),
+		customizeDiffConflictsWhenValue("spot_strategy", []string{"NoSpot", "SpotAsPriceGo"}, "spot_price_limit"),
+	))
+	if err := testCustomizeDiff(r, map[string]interface{}{"spot_strategy": "SpotWithPriceLimit", "spot_price_limit": 0.5}); err != nil {
+		t.Fatalf("the price limit should be allowed, got %v.", err)
+	}
+	err := testCustomizeDiff(r, map[string]interface{}{"spot_strategy": "SpotWithPriceLimit"})
+	if err == nil || !strings.Contains(err.Error(), `"spot_price_limit" is required when "spot_strategy" is "SpotWithPriceLimit"`) {
+		t.Fatalf("the missing price limit should be rejected, got %v.", err)
+	}
+	err = testCustomizeDiff(r, map[string]interface{}{"spot_strategy": "SpotAsPriceGo", "spot_price_limit": 0.5})
+	if err == nil || !strings.Contains(err.Error(), `"spot_price_limit" can not be set when "spot_strategy" is "SpotAsPriceGo"`) {
+		t.Fatalf("the conflicting price limit should be rejected, got %v.", err)
+	}
+}
+
+func TestUnitCustomizeDiffCidrWithinCidrs(t *testing.T) {
+	r := testCustomizeDiffResource(customizeDiffCidrWithinCidrs("cidr_block", func(diff *schema.ResourceDiff, meta interface{}) ([]string, error) {
+		return []string{"172.16.0.0/12", "192.168.0.0/16"}, nil
+	}))
+	for _, cidr := range []string{"172.16.0.0/21", "192.168.1.0/24", "192.168.0.0/16"} {
+		if err := testCustomizeDiff(r, map[string]interface{}{"cidr_block": cidr}); err != nil {
+			t.Fatalf("the CIDR block %s should be allowed, got %v.", cidr, err)
+		}
+	}
+	for _, cidr := range []string{"10.0.0.0/24", "172.0.0.0/8", "not-a-cidr"} {
+		if err := testCustomizeDiff(r, map[string]interface{}{"cidr_block": cidr}); err == nil {
+			t.Fatalf("the CIDR block %s should be rejected.", cidr)
+		}
+	}
+}
@@ -2,7 +2,7 @@ package alicloud
 
 import (
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlicloudActiontrailGlobalEventsStorageRegion() *schema.Resource {
@@ -0,0 +1,71 @@
+package alicloud
+
+import (
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAliCloudApiRequest() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAliCloudApiRequestRead,
+		Schema: map[string]*schema.Schema{
+			"product": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"style": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ApiStyleRpc,
+				ValidateFunc: StringInSlice([]string{ApiStyleRpc, ApiStyleRoa}, false),
+			},
+			"request": apiActionSchema(true),
+			"result_paths": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"results": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"response": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAliCloudApiRequestRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	apiService := ApiService{client}
+
+	action, _ := apiService.expandApiAction(d, "request", "")
+	response, err := apiService.DoApiAction(action)
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alicloud_api_request", action.Action, AlibabaCloudSdkGoERROR)
+	}
+	results, err := apiResults(response, d.Get("result_paths").(map[string]interface{}))
+	if err != nil {
+		return WrapError(err)
+	}
+	d.SetId(dataResourceIdHash([]string{action.Product, action.Version, action.Action}))
+	if err := d.Set("results", results); err != nil {
+		return WrapError(err)
+	}
+	d.Set("response", convertObjectToJsonString(response))
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), response)
+	}
+	return nil
+}
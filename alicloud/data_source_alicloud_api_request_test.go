@@ -0,0 +1,45 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAliCloudApiRequestDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAliCloudApiRequestDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_api_request.default"),
+					resource.TestCheckResourceAttr("data.alicloud_api_request.default", "results.%", "2"),
+					resource.TestCheckResourceAttrSet("data.alicloud_api_request.default", "results.request_id"),
+					resource.TestCheckResourceAttrSet("data.alicloud_api_request.default", "results.zones"),
+					resource.TestCheckResourceAttrSet("data.alicloud_api_request.default", "response"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAliCloudApiRequestDataSourceBasic = `
+data "alicloud_api_request" "default" {
+  product = "vpc"
+  version = "2016-04-28"
+  request {
+    action = "DescribeZones"
+    query = {
+      AcceptLanguage = "en-US"
+    }
+  }
+  result_paths = {
+    request_id = "$.RequestId"
+    zones      = "$.Zones.Zone[*].ZoneId"
+  }
+}
+`
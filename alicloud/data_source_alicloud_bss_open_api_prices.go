@@ -0,0 +1,287 @@
+package alicloud
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/PaesslerAG/jsonpath"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAliCloudBssOpenApiPrices() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAliCloudBssOpenApiPricesRead,
+		Schema: map[string]*schema.Schema{
+			"items": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"product_code": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"product_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"subscription_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: StringInSlice([]string{"PayAsYouGo", "Subscription"}, false),
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"period": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: IntAtLeast(1),
+						},
+						"period_unit": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "Month",
+							ValidateFunc: StringInSlice([]string{"Month", "Year"}, false),
+						},
+						"quantity": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: IntAtLeast(1),
+						},
+						"modules": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"module_code": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"config": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"price_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "Hour",
+										ValidateFunc: StringInSlice([]string{"Hour", "Day", "Month", "Year", "Usage"}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"prices": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"product_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subscription_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"currency": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"original_amount": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"discount_amount": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"trade_amount": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"modules": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"module_code": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"original_amount": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									"discount_amount": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+									"trade_amount": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"currency": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"total_original_amount": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"total_discount_amount": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"total_trade_amount": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAliCloudBssOpenApiPricesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	bssOpenApiService := BssOpenApiService{client}
+
+	var ids []string
+	var prices []map[string]interface{}
+	var totalOriginal, totalDiscount, totalTrade float64
+	currency := ""
+	for i, v := range d.Get("items").([]interface{}) {
+		item := v.(map[string]interface{})
+		request := map[string]interface{}{
+			"ProductCode":      item["product_code"],
+			"SubscriptionType": item["subscription_type"],
+			"Region":           client.RegionId,
+		}
+		if v := item["product_type"].(string); v != "" {
+			request["ProductType"] = v
+		}
+		if v := item["region"].(string); v != "" {
+			request["Region"] = v
+		}
+		for j, m := range item["modules"].([]interface{}) {
+			module := m.(map[string]interface{})
+			request[fmt.Sprintf("ModuleList.%d.ModuleCode", j+1)] = module["module_code"]
+			request[fmt.Sprintf("ModuleList.%d.Config", j+1)] = module["config"]
+			if item["subscription_type"] == "PayAsYouGo" {
+				request[fmt.Sprintf("ModuleList.%d.PriceType", j+1)] = module["price_type"]
+			}
+		}
+
+		action := "GetPayAsYouGoPrice"
+		quantity := float64(item["quantity"].(int))
+		if item["subscription_type"] == "Subscription" {
+			action = "GetSubscriptionPrice"
+			request["OrderType"] = "NewOrder"
+			request["ServicePeriodQuantity"] = item["period"]
+			request["ServicePeriodUnit"] = item["period_unit"]
+			request["Quantity"] = item["quantity"]
+			// The subscription price has been multiplied by the quantity.
+			quantity = 1
+		}
+		object, err := bssOpenApiService.GetPrice(action, request)
+		if err != nil {
+			return WrapError(err)
+		}
+
+		price := map[string]interface{}{
+			"product_code":      item["product_code"],
+			"subscription_type": item["subscription_type"],
+			"currency":          fmt.Sprint(object["Currency"]),
+		}
+		var original, discount, trade float64
+		var modules []map[string]interface{}
+		details, _ := jsonpath.Get("$.ModuleDetails.ModuleDetail", object)
+		detailList, _ := details.([]interface{})
+		for _, detail := range detailList {
+			moduleDetail, ok := detail.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			module := map[string]interface{}{
+				"module_code":     fmt.Sprint(moduleDetail["ModuleCode"]),
+				"original_amount": roundPrice(priceAmount(moduleDetail["OriginalCost"]) * quantity),
+				"discount_amount": roundPrice(priceAmount(moduleDetail["InvoiceDiscount"]) * quantity),
+				"trade_amount":    roundPrice(priceAmount(moduleDetail["CostAfterDiscount"]) * quantity),
+			}
+			original += module["original_amount"].(float64)
+			discount += module["discount_amount"].(float64)
+			trade += module["trade_amount"].(float64)
+			modules = append(modules, module)
+		}
+		if action == "GetSubscriptionPrice" {
+			original, discount, trade = priceAmount(object["OriginalPrice"]), priceAmount(object["DiscountPrice"]), priceAmount(object["TradePrice"])
+		}
+		price["original_amount"] = roundPrice(original)
+		price["discount_amount"] = roundPrice(discount)
+		price["trade_amount"] = roundPrice(trade)
+		price["modules"] = modules
+		prices = append(prices, price)
+
+		if currency == "" {
+			currency = price["currency"].(string)
+		} else if currency != price["currency"] {
+			return WrapError(fmt.Errorf("the prices of the items %d and earlier ones are in the different currencies %s and %s", i, price["currency"], currency))
+		}
+		totalOriginal += original
+		totalDiscount += discount
+		totalTrade += trade
+		ids = append(ids, fmt.Sprintf("%s:%s:%d", item["product_code"], item["subscription_type"], i))
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("prices", prices); err != nil {
+		return WrapError(err)
+	}
+	d.Set("currency", currency)
+	d.Set("total_original_amount", roundPrice(totalOriginal))
+	d.Set("total_discount_amount", roundPrice(totalDiscount))
+	d.Set("total_trade_amount", roundPrice(totalTrade))
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), prices)
+	}
+	return nil
+}
+
+func priceAmount(v interface{}) float64 {
+	switch amount := v.(type) {
+	case float64:
+		return amount
+	case string:
+		f, _ := strconv.ParseFloat(amount, 64)
+		return f
+	}
+	if v == nil {
+		return 0
+	}
+	f, _ := strconv.ParseFloat(fmt.Sprint(v), 64)
+	return f
+}
+
+// roundPrice rounds the amount to 4 decimal places, which avoids the floating point noise of the sums.
+func roundPrice(amount float64) float64 {
+	return math.Round(amount*10000) / 10000
+}
@@ -0,0 +1,61 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAliCloudBssOpenApiPricesDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAliCloudBssOpenApiPricesDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_bss_open_api_prices.default"),
+					resource.TestCheckResourceAttr("data.alicloud_bss_open_api_prices.default", "prices.#", "2"),
+					resource.TestCheckResourceAttr("data.alicloud_bss_open_api_prices.default", "prices.0.subscription_type", "PayAsYouGo"),
+					resource.TestCheckResourceAttrSet("data.alicloud_bss_open_api_prices.default", "prices.0.trade_amount"),
+					resource.TestCheckResourceAttr("data.alicloud_bss_open_api_prices.default", "prices.1.subscription_type", "Subscription"),
+					resource.TestCheckResourceAttrSet("data.alicloud_bss_open_api_prices.default", "prices.1.trade_amount"),
+					resource.TestCheckResourceAttrSet("data.alicloud_bss_open_api_prices.default", "currency"),
+					resource.TestCheckResourceAttrSet("data.alicloud_bss_open_api_prices.default", "total_trade_amount"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAliCloudBssOpenApiPricesDataSourceBasic = `
+data "alicloud_regions" "default" {
+  current = true
+}
+
+data "alicloud_bss_open_api_prices" "default" {
+  items {
+    product_code      = "ecs"
+    subscription_type = "PayAsYouGo"
+    region            = data.alicloud_regions.default.regions.0.id
+    quantity          = 2
+    modules {
+      module_code = "InstanceType"
+      config      = "InstanceType:ecs.g6.large,IoOptimized:IoOptimized,ImageOs:linux"
+    }
+  }
+  items {
+    product_code      = "ecs"
+    subscription_type = "Subscription"
+    region            = data.alicloud_regions.default.regions.0.id
+    period            = 1
+    period_unit       = "Month"
+    modules {
+      module_code = "InstanceType"
+      config      = "InstanceType:ecs.g6.large,IoOptimized:IoOptimized,ImageOs:linux"
+    }
+  }
+}
+`
@@ -6,8 +6,8 @@ import (
 
 	"github.com/PaesslerAG/jsonpath"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAliCloudCloudFirewallControlPolicies() *schema.Resource {
@@ -184,9 +184,9 @@ func dataSourceAliCloudCloudFirewallControlPolicies() *schema.Resource {
 				},
 			},
 			"source_ip": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Removed:  "Field `upgrade_type` has been removed from provider version 1.213.0.",
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "Field `upgrade_type` has been removed from provider version 1.213.0.",
 			},
 		},
 	}
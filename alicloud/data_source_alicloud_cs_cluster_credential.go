@@ -7,8 +7,8 @@ import (
 
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlicloudCSClusterCredential() *schema.Resource {
@@ -42,25 +42,7 @@ func dataSourceAlicloudCSClusterCredential() *schema.Resource {
 			"certificate_authority": {
 				Type:     schema.TypeMap,
 				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"cluster_cert": {
-							Type:      schema.TypeString,
-							Sensitive: true,
-							Computed:  true,
-						},
-						"client_cert": {
-							Type:      schema.TypeString,
-							Sensitive: true,
-							Computed:  true,
-						},
-						"client_key": {
-							Type:      schema.TypeString,
-							Sensitive: true,
-							Computed:  true,
-						},
-					},
-				},
+				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"expiration": {
 				Type:     schema.TypeString,
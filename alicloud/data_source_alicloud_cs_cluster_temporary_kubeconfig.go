@@ -0,0 +1,169 @@
+package alicloud
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func dataSourceAliCloudCSClusterTemporaryKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAliCloudCSClusterTemporaryKubeconfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"temporary_duration_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntBetween(15, 4320),
+			},
+			"private_ip_address": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			// Computed fields
+			"host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cluster_ca_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_certificate": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"exec": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"command": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"args": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"env": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"kube_config": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAliCloudCSClusterTemporaryKubeconfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	roaClient, err := client.NewRoaCsClient()
+	if err != nil {
+		return WrapError(err)
+	}
+
+	csClient := CsClient{roaClient}
+
+	clusterId := d.Get("cluster_id").(string)
+	privateIpAddress := d.Get("private_ip_address").(bool)
+	credential, err := csClient.DescribeClusterKubeConfigWithEndpoint(clusterId, int64(d.Get("temporary_duration_minutes").(int)), privateIpAddress)
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alicloud_cs_cluster_temporary_kubeconfig", "DescribeClusterUserKubeconfig", AlibabaCloudSdkGoERROR)
+	}
+
+	kubeConfig, err := flattenAliCloudCSTemporaryKubeconfig(tea.StringValue(credential.Config))
+	if err != nil {
+		return WrapError(err)
+	}
+	for key, value := range kubeConfig {
+		d.Set(key, value)
+	}
+	d.Set("kube_config", tea.StringValue(credential.Config))
+	d.Set("expiration", tea.StringValue(credential.Expiration))
+	d.SetId(dataResourceIdHash([]string{clusterId, strconv.FormatBool(privateIpAddress)}))
+
+	return nil
+}
+
+// flattenAliCloudCSTemporaryKubeconfig parses the server and the credential of the current context of the kubeconfig.
+// The certificates and the key are returned in PEM format, so they can be used by the kubernetes and helm providers directly.
+func flattenAliCloudCSTemporaryKubeconfig(config string) (map[string]interface{}, error) {
+	kubeConfig, err := clientcmd.Load([]byte(config))
+	if err != nil {
+		return nil, err
+	}
+	context, ok := kubeConfig.Contexts[kubeConfig.CurrentContext]
+	if !ok {
+		return nil, fmt.Errorf("the current context %q is not found in the kubeconfig", kubeConfig.CurrentContext)
+	}
+	cluster, ok := kubeConfig.Clusters[context.Cluster]
+	if !ok {
+		return nil, fmt.Errorf("the cluster %q is not found in the kubeconfig", context.Cluster)
+	}
+	authInfo, ok := kubeConfig.AuthInfos[context.AuthInfo]
+	if !ok {
+		return nil, fmt.Errorf("the user %q is not found in the kubeconfig", context.AuthInfo)
+	}
+
+	execMaps := make([]map[string]interface{}, 0)
+	if authInfo.Exec != nil {
+		env := make(map[string]interface{})
+		for _, envVar := range authInfo.Exec.Env {
+			env[envVar.Name] = envVar.Value
+		}
+		execMaps = append(execMaps, map[string]interface{}{
+			"api_version": authInfo.Exec.APIVersion,
+			"command":     authInfo.Exec.Command,
+			"args":        authInfo.Exec.Args,
+			"env":         env,
+		})
+	}
+
+	return map[string]interface{}{
+		"host":                   cluster.Server,
+		"cluster_ca_certificate": string(cluster.CertificateAuthorityData),
+		"client_certificate":     string(authInfo.ClientCertificateData),
+		"client_key":             string(authInfo.ClientKeyData),
+		"token":                  authInfo.Token,
+		"exec":                   execMaps,
+	}, nil
+}
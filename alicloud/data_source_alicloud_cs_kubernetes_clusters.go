@@ -13,9 +13,9 @@ import (
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/cs"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlicloudCSKubernetesClusters() *schema.Resource {
@@ -34,7 +34,7 @@ func dataSourceAlicloudCSKubernetesClusters() *schema.Resource {
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.ValidateRegexp,
+				ValidateFunc: validation.StringIsValidRegExp,
 			},
 			"enable_details": {
 				Type:     schema.TypeBool,
@@ -265,26 +265,7 @@ func dataSourceAlicloudCSKubernetesClusters() *schema.Resource {
 						"connections": {
 							Type:     schema.TypeMap,
 							Computed: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"api_server_internet": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"api_server_intranet": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"master_public_ip": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"service_domain": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-								},
-							},
+							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
 					},
 				},
@@ -12,9 +12,9 @@ import (
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/cs"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlicloudCSManagerKubernetesClusters() *schema.Resource {
@@ -33,7 +33,7 @@ func dataSourceAlicloudCSManagerKubernetesClusters() *schema.Resource {
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.ValidateRegexp,
+				ValidateFunc: validation.StringIsValidRegExp,
 			},
 			"enable_details": {
 				Type:     schema.TypeBool,
@@ -74,9 +74,9 @@ func dataSourceAlicloudCSManagerKubernetesClusters() *schema.Resource {
 							Computed: true,
 						},
 						"slb_internet_enabled": {
-							Type:     schema.TypeBool,
-							Computed: true,
-							Removed:  "Field 'slb_internet_enabled' has been removed from provider version 1.53.0.",
+							Type:       schema.TypeBool,
+							Computed:   true,
+							Deprecated: "Field 'slb_internet_enabled' has been removed from provider version 1.53.0.",
 						},
 						"security_group_id": {
 							Type:     schema.TypeString,
@@ -96,7 +96,7 @@ func dataSourceAlicloudCSManagerKubernetesClusters() *schema.Resource {
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
-							Removed: "Field 'vswitch_ids' has been removed from provider version 1.53.0.",
+							Deprecated: "Field 'vswitch_ids' has been removed from provider version 1.53.0.",
 						},
 						"worker_instance_types": {
 							Type:     schema.TypeList,
@@ -104,7 +104,7 @@ func dataSourceAlicloudCSManagerKubernetesClusters() *schema.Resource {
 							Elem: &schema.Schema{
 								Type: schema.TypeString,
 							},
-							Removed: "Field 'worker_instance_types' has been removed from provider version 1.53.0.",
+							Deprecated: "Field 'worker_instance_types' has been removed from provider version 1.53.0.",
 						},
 						"worker_numbers": {
 							Type:     schema.TypeList,
@@ -112,27 +112,27 @@ func dataSourceAlicloudCSManagerKubernetesClusters() *schema.Resource {
 							Elem: &schema.Schema{
 								Type: schema.TypeInt,
 							},
-							Removed: "Field 'worker_numbers' has been removed from provider version 1.53.0.",
+							Deprecated: "Field 'worker_numbers' has been removed from provider version 1.53.0.",
 						},
 						"key_name": {
-							Type:     schema.TypeString,
-							Computed: true,
-							Removed:  "Field 'key_name' has been removed from provider version 1.53.0.",
+							Type:       schema.TypeString,
+							Computed:   true,
+							Deprecated: "Field 'key_name' has been removed from provider version 1.53.0.",
 						},
 						"pod_cidr": {
-							Type:     schema.TypeString,
-							Computed: true,
-							Removed:  "Field 'pod_cidr' has been removed from provider version 1.53.0.",
+							Type:       schema.TypeString,
+							Computed:   true,
+							Deprecated: "Field 'pod_cidr' has been removed from provider version 1.53.0.",
 						},
 						"service_cidr": {
-							Type:     schema.TypeString,
-							Computed: true,
-							Removed:  "Field 'service_cidr' has been removed from provider version 1.53.0.",
+							Type:       schema.TypeString,
+							Computed:   true,
+							Deprecated: "Field 'service_cidr' has been removed from provider version 1.53.0.",
 						},
 						"cluster_network_type": {
-							Type:     schema.TypeString,
-							Computed: true,
-							Removed:  "Field 'cluster_network_type' has been removed from provider version 1.53.0.",
+							Type:       schema.TypeString,
+							Computed:   true,
+							Deprecated: "Field 'cluster_network_type' has been removed from provider version 1.53.0.",
 						},
 						"log_config": {
 							Type:     schema.TypeList,
@@ -149,57 +149,57 @@ func dataSourceAlicloudCSManagerKubernetesClusters() *schema.Resource {
 									},
 								},
 							},
-							Removed: "Field 'log_config' has been removed from provider version 1.53.0.",
+							Deprecated: "Field 'log_config' has been removed from provider version 1.53.0.",
 						},
 						"image_id": {
-							Type:     schema.TypeString,
-							Computed: true,
-							Removed:  "Field 'image_id' has been removed from provider version 1.53.0.",
+							Type:       schema.TypeString,
+							Computed:   true,
+							Deprecated: "Field 'image_id' has been removed from provider version 1.53.0.",
 						},
 						"worker_disk_size": {
-							Type:     schema.TypeInt,
-							Computed: true,
-							Removed:  "Field 'worker_disk_size' has been removed from provider version 1.53.0.",
+							Type:       schema.TypeInt,
+							Computed:   true,
+							Deprecated: "Field 'worker_disk_size' has been removed from provider version 1.53.0.",
 						},
 						"worker_disk_category": {
-							Type:     schema.TypeString,
-							Computed: true,
-							Removed:  "Field 'worker_disk_category' has been removed from provider version 1.53.0.",
+							Type:       schema.TypeString,
+							Computed:   true,
+							Deprecated: "Field 'worker_disk_category' has been removed from provider version 1.53.0.",
 						},
 						"worker_data_disk_size": {
-							Type:     schema.TypeInt,
-							Computed: true,
-							Removed:  "Field 'worker_data_disk_size' has been removed from provider version 1.53.0.",
+							Type:       schema.TypeInt,
+							Computed:   true,
+							Deprecated: "Field 'worker_data_disk_size' has been removed from provider version 1.53.0.",
 						},
 						"worker_data_disk_category": {
-							Type:     schema.TypeString,
-							Computed: true,
-							Removed:  "Field 'worker_data_disk_category' has been removed from provider version 1.53.0.",
+							Type:       schema.TypeString,
+							Computed:   true,
+							Deprecated: "Field 'worker_data_disk_category' has been removed from provider version 1.53.0.",
 						},
 						"worker_instance_charge_type": {
-							Type:     schema.TypeString,
-							Computed: true,
-							Removed:  "Field 'worker_instance_charge_type' has been removed from provider version 1.53.0.",
+							Type:       schema.TypeString,
+							Computed:   true,
+							Deprecated: "Field 'worker_instance_charge_type' has been removed from provider version 1.53.0.",
 						},
 						"worker_period_unit": {
-							Type:     schema.TypeString,
-							Computed: true,
-							Removed:  "Field 'worker_period_unit' has been removed from provider version 1.53.0.",
+							Type:       schema.TypeString,
+							Computed:   true,
+							Deprecated: "Field 'worker_period_unit' has been removed from provider version 1.53.0.",
 						},
 						"worker_period": {
-							Type:     schema.TypeInt,
-							Computed: true,
-							Removed:  "Field 'worker_period' has been removed from provider version 1.53.0.",
+							Type:       schema.TypeInt,
+							Computed:   true,
+							Deprecated: "Field 'worker_period' has been removed from provider version 1.53.0.",
 						},
 						"worker_auto_renew": {
-							Type:     schema.TypeBool,
-							Computed: true,
-							Removed:  "Field 'worker_auto_renew' has been removed from provider version 1.53.0.",
+							Type:       schema.TypeBool,
+							Computed:   true,
+							Deprecated: "Field 'worker_auto_renew' has been removed from provider version 1.53.0.",
 						},
 						"worker_auto_renew_period": {
-							Type:     schema.TypeInt,
-							Computed: true,
-							Removed:  "Field 'worker_auto_renew_period' has been removed from provider version 1.53.0.",
+							Type:       schema.TypeInt,
+							Computed:   true,
+							Deprecated: "Field 'worker_auto_renew_period' has been removed from provider version 1.53.0.",
 						},
 						"worker_nodes": {
 							Type:     schema.TypeList,
@@ -224,26 +224,7 @@ func dataSourceAlicloudCSManagerKubernetesClusters() *schema.Resource {
 						"connections": {
 							Type:     schema.TypeMap,
 							Computed: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"api_server_internet": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"api_server_intranet": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"master_public_ip": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"service_domain": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-								},
-							},
+							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
 					},
 				},
@@ -10,8 +10,8 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 	"github.com/denverdino/aliyungo/cs"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlicloudCSServerlessKubernetesClusters() *schema.Resource {
@@ -29,7 +29,7 @@ func dataSourceAlicloudCSServerlessKubernetesClusters() *schema.Resource {
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.ValidateRegexp,
+				ValidateFunc: validation.StringIsValidRegExp,
 			},
 			"enable_details": {
 				Type:     schema.TypeBool,
@@ -92,22 +92,7 @@ func dataSourceAlicloudCSServerlessKubernetesClusters() *schema.Resource {
 						"connections": {
 							Type:     schema.TypeMap,
 							Computed: true,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"api_server_internet": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"api_server_intranet": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-									"master_public_ip": {
-										Type:     schema.TypeString,
-										Computed: true,
-									},
-								},
-							},
+							Elem:     &schema.Schema{Type: schema.TypeString},
 						},
 						"tags": {
 							Type:     schema.TypeMap,
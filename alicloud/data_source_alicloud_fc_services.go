@@ -5,8 +5,8 @@ import (
 
 	"github.com/aliyun/fc-go-sdk"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlicloudFcServices() *schema.Resource {
@@ -17,7 +17,7 @@ func dataSourceAlicloudFcServices() *schema.Resource {
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.ValidateRegexp,
+				ValidateFunc: validation.StringIsValidRegExp,
 				ForceNew:     true,
 			},
 			"output_file": {
@@ -85,7 +85,6 @@ func dataSourceAlicloudFcServices() *schema.Resource {
 									},
 								},
 							},
-							MaxItems: 1,
 						},
 						"vpc_config": {
 							Type:     schema.TypeList,
@@ -109,7 +108,6 @@ func dataSourceAlicloudFcServices() *schema.Resource {
 									},
 								},
 							},
-							MaxItems: 1,
 						},
 						"nas_config": {
 							Type:     schema.TypeList,
@@ -142,7 +140,6 @@ func dataSourceAlicloudFcServices() *schema.Resource {
 									},
 								},
 							},
-							MaxItems: 1,
 						},
 					},
 				},
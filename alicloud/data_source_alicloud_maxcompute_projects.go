@@ -9,9 +9,9 @@ import (
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAliCloudMaxComputeProjects() *schema.Resource {
@@ -28,7 +28,7 @@ func dataSourceAliCloudMaxComputeProjects() *schema.Resource {
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.ValidateRegexp,
+				ValidateFunc: validation.StringIsValidRegExp,
 				ForceNew:     true,
 			},
 			"names": {
@@ -60,7 +60,6 @@ func dataSourceAliCloudMaxComputeProjects() *schema.Resource {
 						"ip_white_list": {
 							Type:     schema.TypeList,
 							Computed: true,
-							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"vpc_ip_list": {
@@ -85,7 +84,6 @@ func dataSourceAliCloudMaxComputeProjects() *schema.Resource {
 						"properties": {
 							Type:     schema.TypeList,
 							Computed: true,
-							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"timezone": {
@@ -103,7 +101,6 @@ func dataSourceAliCloudMaxComputeProjects() *schema.Resource {
 									"table_lifecycle": {
 										Type:     schema.TypeList,
 										Computed: true,
-										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"type": {
@@ -124,7 +121,6 @@ func dataSourceAliCloudMaxComputeProjects() *schema.Resource {
 									"encryption": {
 										Type:     schema.TypeList,
 										Computed: true,
-										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"enable": {
@@ -156,7 +152,6 @@ func dataSourceAliCloudMaxComputeProjects() *schema.Resource {
 						"security_properties": {
 							Type:     schema.TypeList,
 							Computed: true,
-							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"using_policy": {
@@ -186,7 +181,6 @@ func dataSourceAliCloudMaxComputeProjects() *schema.Resource {
 									"project_protection": {
 										Type:     schema.TypeList,
 										Computed: true,
-										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"protected": {
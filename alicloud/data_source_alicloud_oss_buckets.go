@@ -8,8 +8,8 @@ import (
 
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlicloudOssBuckets() *schema.Resource {
@@ -20,7 +20,7 @@ func dataSourceAlicloudOssBuckets() *schema.Resource {
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.ValidateRegexp,
+				ValidateFunc: validation.StringIsValidRegExp,
 				ForceNew:     true,
 			},
 			"output_file": {
@@ -125,7 +125,6 @@ func dataSourceAlicloudOssBuckets() *schema.Resource {
 									},
 								},
 							},
-							MaxItems: 1,
 						},
 
 						"logging": {
@@ -143,7 +142,6 @@ func dataSourceAlicloudOssBuckets() *schema.Resource {
 									},
 								},
 							},
-							MaxItems: 1,
 						},
 
 						"referer_config": {
@@ -162,7 +160,6 @@ func dataSourceAlicloudOssBuckets() *schema.Resource {
 									},
 								},
 							},
-							MaxItems: 1,
 						},
 
 						"lifecycle_rule": {
@@ -197,7 +194,6 @@ func dataSourceAlicloudOssBuckets() *schema.Resource {
 												},
 											},
 										},
-										MaxItems: 1,
 									},
 								},
 							},
@@ -223,7 +219,6 @@ func dataSourceAlicloudOssBuckets() *schema.Resource {
 									},
 								},
 							},
-							MaxItems: 1,
 						},
 
 						"tags": tagsSchemaComputed(),
@@ -239,7 +234,6 @@ func dataSourceAlicloudOssBuckets() *schema.Resource {
 									},
 								},
 							},
-							MaxItems: 1,
 						},
 					},
 				},
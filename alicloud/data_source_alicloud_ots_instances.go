@@ -2,8 +2,8 @@ package alicloud
 
 import (
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"regexp"
 )
 
@@ -24,7 +24,7 @@ func dataSourceAlicloudOtsInstances() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.ValidateRegexp,
+				ValidateFunc: validation.StringIsValidRegExp,
 			},
 			"tags": tagsSchema(),
 			"output_file": {
@@ -72,9 +72,9 @@ func dataSourceAlicloudOtsInstances() *schema.Resource {
 							Computed: true,
 						},
 						"network": {
-							Type:     schema.TypeString,
-							Computed: true,
-							Removed:  "Field 'network' has been removed from provider version v1.221.0. Please Use the 'network_type_acl' and 'network_source_acl'",
+							Type:       schema.TypeString,
+							Computed:   true,
+							Deprecated: "Field 'network' has been removed from provider version v1.221.0. Please Use the 'network_type_acl' and 'network_source_acl'",
 						},
 						"network_type_acl": {
 							Type:     schema.TypeSet,
@@ -99,9 +99,9 @@ func dataSourceAlicloudOtsInstances() *schema.Resource {
 							Computed: true,
 						},
 						"entity_quota": {
-							Type:     schema.TypeInt,
-							Computed: true,
-							Removed:  "Field 'entity_quota' has been removed from provider version v1.221.0. Please Use the 'table_quota'",
+							Type:       schema.TypeInt,
+							Computed:   true,
+							Deprecated: "Field 'entity_quota' has been removed from provider version v1.221.0. Please Use the 'table_quota'",
 						},
 						"table_quota": {
 							Type:     schema.TypeInt,
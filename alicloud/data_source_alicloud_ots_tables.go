@@ -6,8 +6,8 @@ import (
 
 	"github.com/aliyun/aliyun-tablestore-go-sdk/tablestore"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlicloudOtsTables() *schema.Resource {
@@ -33,7 +33,7 @@ func dataSourceAlicloudOtsTables() *schema.Resource {
 				Type:         schema.TypeString,
 				Optional:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.ValidateRegexp,
+				ValidateFunc: validation.StringIsValidRegExp,
 			},
 			"output_file": {
 				Type:     schema.TypeString,
@@ -78,7 +78,6 @@ func dataSourceAlicloudOtsTables() *schema.Resource {
 									},
 								},
 							},
-							MaxItems: 4,
 						},
 						"defined_column": {
 							Type:     schema.TypeList,
@@ -95,7 +94,6 @@ func dataSourceAlicloudOtsTables() *schema.Resource {
 									},
 								},
 							},
-							MaxItems: 32,
 						},
 						"time_to_live": {
 							Type:     schema.TypeInt,
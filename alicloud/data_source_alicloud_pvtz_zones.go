@@ -7,8 +7,8 @@ import (
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlicloudPvtzZones() *schema.Resource {
@@ -57,7 +57,7 @@ func dataSourceAlicloudPvtzZones() *schema.Resource {
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.ValidateRegexp,
+				ValidateFunc: validation.StringIsValidRegExp,
 				ForceNew:     true,
 			},
 			"names": {
@@ -147,14 +147,14 @@ func dataSourceAlicloudPvtzZones() *schema.Resource {
 							Computed: true,
 						},
 						"creation_time": {
-							Type:     schema.TypeString,
-							Computed: true,
-							Removed:  "Field 'creation_time' has been removed from provider version 1.107.0",
+							Type:       schema.TypeString,
+							Computed:   true,
+							Deprecated: "Field 'creation_time' has been removed from provider version 1.107.0",
 						},
 						"update_time": {
-							Type:     schema.TypeString,
-							Computed: true,
-							Removed:  "Field 'update_time' has been removed from provider version 1.107.0",
+							Type:       schema.TypeString,
+							Computed:   true,
+							Deprecated: "Field 'update_time' has been removed from provider version 1.107.0",
 						},
 					},
 				},
@@ -9,8 +9,8 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ram"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlicloudRamUsers() *schema.Resource {
@@ -74,9 +74,9 @@ func dataSourceAlicloudRamUsers() *schema.Resource {
 							Computed: true,
 						},
 						"last_login_date": {
-							Removed:  "Field 'last_login_date' has been removed from provider version 1.79.0.",
-							Type:     schema.TypeString,
-							Computed: true,
+							Deprecated: "Field 'last_login_date' has been removed from provider version 1.79.0.",
+							Type:       schema.TypeString,
+							Computed:   true,
 						},
 					},
 				},
@@ -0,0 +1,77 @@
+package alicloud
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAliCloudResolvedEndpoints() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAliCloudResolvedEndpointsRead,
+		Schema: map[string]*schema.Schema{
+			"products": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"endpoints": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"endpoints_xml": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAliCloudResolvedEndpointsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+
+	endpoints := make(map[string]string)
+	if v, ok := d.GetOk("products"); ok && len(v.([]interface{})) > 0 {
+		for _, product := range v.([]interface{}) {
+			productCode := strings.ToLower(strings.TrimSpace(product.(string)))
+			endpoint, err := client.ResolveEndpoint(productCode)
+			if err != nil {
+				return WrapError(err)
+			}
+			endpoints[productCode] = endpoint
+		}
+	} else {
+		endpoints = client.CachedEndpoints()
+	}
+
+	var endpointsXml bytes.Buffer
+	if err := connectivity.WriteEndpointsXml(&endpointsXml, map[string]map[string]string{client.RegionId: endpoints}); err != nil {
+		return WrapError(err)
+	}
+
+	productCodes := make([]string, 0, len(endpoints))
+	for productCode := range endpoints {
+		productCodes = append(productCodes, productCode)
+	}
+	sort.Strings(productCodes)
+	d.SetId(dataResourceIdHash(productCodes))
+	if err := d.Set("endpoints", endpoints); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("endpoints_xml", endpointsXml.String()); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), endpointsXml.String())
+	}
+	return nil
+}
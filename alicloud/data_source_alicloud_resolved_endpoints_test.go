@@ -0,0 +1,34 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAliCloudResolvedEndpointsDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAliCloudResolvedEndpointsDataSourceBasic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAlicloudDataSourceID("data.alicloud_resolved_endpoints.default"),
+					resource.TestCheckResourceAttr("data.alicloud_resolved_endpoints.default", "endpoints.%", "2"),
+					resource.TestCheckResourceAttrSet("data.alicloud_resolved_endpoints.default", "endpoints.ecs"),
+					resource.TestCheckResourceAttrSet("data.alicloud_resolved_endpoints.default", "endpoints.vpc"),
+					resource.TestCheckResourceAttrSet("data.alicloud_resolved_endpoints.default", "endpoints_xml"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCheckAliCloudResolvedEndpointsDataSourceBasic = `
+data "alicloud_resolved_endpoints" "default" {
+  products = ["ecs", "vpc"]
+}
+`
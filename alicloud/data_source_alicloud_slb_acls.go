@@ -5,8 +5,8 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlicloudSlbAcls() *schema.Resource {
@@ -26,7 +26,7 @@ func dataSourceAlicloudSlbAcls() *schema.Resource {
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.ValidateRegexp,
+				ValidateFunc: validation.StringIsValidRegExp,
 				ForceNew:     true,
 			},
 			"output_file": {
@@ -76,7 +76,6 @@ func dataSourceAlicloudSlbAcls() *schema.Resource {
 									},
 								},
 							},
-							MinItems: 0,
 						},
 						"related_listeners": {
 							Type:     schema.TypeList,
@@ -101,7 +100,6 @@ func dataSourceAlicloudSlbAcls() *schema.Resource {
 									},
 								},
 							},
-							MinItems: 0,
 						},
 						"tags": tagsSchema(),
 						"resource_group_id": {
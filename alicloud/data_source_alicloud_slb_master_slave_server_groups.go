@@ -5,8 +5,8 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlicloudSlbMasterSlaveServerGroups() *schema.Resource {
@@ -29,7 +29,7 @@ func dataSourceAlicloudSlbMasterSlaveServerGroups() *schema.Resource {
 			"name_regex": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ValidateFunc: validation.ValidateRegexp,
+				ValidateFunc: validation.StringIsValidRegExp,
 				ForceNew:     true,
 			},
 			"output_file": {
@@ -77,9 +77,9 @@ func dataSourceAlicloudSlbMasterSlaveServerGroups() *schema.Resource {
 										Computed: true,
 									},
 									"is_backup": {
-										Type:     schema.TypeInt,
-										Computed: true,
-										Removed:  "Field 'is_backup' has been removed from provider version 1.63.0.",
+										Type:       schema.TypeInt,
+										Computed:   true,
+										Deprecated: "Field 'is_backup' has been removed from provider version 1.63.0.",
 									},
 								},
 							},
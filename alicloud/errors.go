@@ -1,6 +1,8 @@
 package alicloud
 
 import (
+	"encoding/json"
+	stderrors "errors"
 	"regexp"
 	"strings"
 
@@ -17,6 +19,7 @@ import (
 	"github.com/aliyun/aliyun-datahub-sdk-go/datahub"
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/aliyun/fc-go-sdk"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 	"github.com/denverdino/aliyungo/common"
 )
 
@@ -78,6 +81,98 @@ func (err *ProviderError) Message() string {
 	return err.message
 }
 
+// ApiError is the typed error of the Alibaba Cloud APIs, which normalizes the errors of the tea SDK,
+// alibaba-cloud-sdk-go and denverdino/aliyungo. WrapError and WrapErrorf wrap the API errors as it,
+// and it can be found by errors.As, like:
+//
+//	var apiErr *ApiError
+//	if errors.As(err, &apiErr) && apiErr.Code == "Forbidden.RAM" {
+//	}
+type ApiError struct {
+	Code         string
+	Message      string
+	RequestId    string
+	HttpStatus   int
+	RecommendUrl string
+	// Cause is the origin error returned by the SDK
+	Cause error
+}
+
+func (e *ApiError) Error() string {
+	msg := fmt.Sprintf("Code: %s, Message: %s, RequestId: %s, HttpStatus: %d", e.Code, e.Message, e.RequestId, e.HttpStatus)
+	if e.RecommendUrl != "" {
+		msg += fmt.Sprintf(", Recommend: %s", e.RecommendUrl)
+	}
+	if e.Cause != nil {
+		msg += "\n" + e.Cause.Error()
+	}
+	return msg
+}
+
+func (e *ApiError) Unwrap() error {
+	return e.Cause
+}
+
+// NewApiError returns the ApiError of the SDK error. It returns nil if the error is not an API error.
+func NewApiError(err error) *ApiError {
+	switch e := err.(type) {
+	case *ApiError:
+		return e
+	case *tea.SDKError:
+		apiErr := &ApiError{
+			Code:       tea.StringValue(e.Code),
+			Message:    tea.StringValue(e.Message),
+			HttpStatus: tea.IntValue(e.StatusCode),
+			Cause:      err,
+		}
+		data := make(map[string]interface{})
+		if json.Unmarshal([]byte(tea.StringValue(e.Data)), &data) == nil {
+			for _, key := range []string{"RequestId", "requestId"} {
+				if v, ok := data[key].(string); ok && v != "" {
+					apiErr.RequestId = v
+				}
+			}
+			for _, key := range []string{"Recommend", "recommend"} {
+				if v, ok := data[key].(string); ok && v != "" {
+					apiErr.RecommendUrl = v
+				}
+			}
+		}
+		return apiErr
+	case *errors.ServerError:
+		return &ApiError{
+			Code:         e.ErrorCode(),
+			Message:      e.Message(),
+			RequestId:    e.RequestId(),
+			HttpStatus:   e.HttpStatus(),
+			RecommendUrl: e.Recommend(),
+			Cause:        err,
+		}
+	case *common.Error:
+		return &ApiError{
+			Code:       e.Code,
+			Message:    e.Message,
+			RequestId:  e.RequestId,
+			HttpStatus: e.StatusCode,
+			Cause:      err,
+		}
+	}
+	return nil
+}
+
+// ApiErrorCode returns the code of the API error in the error chain. It returns an empty string if there is
+// no API error. It can be used to match the codes exactly, like IsExpectedErrorCodes(ApiErrorCode(err), codes).
+func ApiErrorCode(err error) string {
+	var apiErr *ApiError
+	if stderrors.As(err, &apiErr) {
+		return apiErr.Code
+	}
+	if apiErr = NewApiError(err); apiErr != nil {
+		return apiErr.Code
+	}
+	return ""
+}
+
 func GetNotFoundErrorFromString(str string) error {
 	return &ProviderError{
 		errorCode: InstanceNotFound,
@@ -94,8 +189,8 @@ func NotFoundError(err error) bool {
 		}
 		return NotFoundError(e.Cause)
 	}
-	if err == nil {
-		return false
+	if e, ok := err.(*ApiError); ok {
+		return NotFoundError(e.Cause)
 	}
 
 	if e, ok := err.(*tea.SDKError); ok {
@@ -130,6 +225,10 @@ func IsExpectedErrors(err error, expectCodes []string) bool {
 		return IsExpectedErrors(e.Cause, expectCodes)
 	}
 
+	if e, ok := err.(*ApiError); ok {
+		return IsExpectedErrors(e.Cause, expectCodes)
+	}
+
 	if e, ok := err.(*tea.SDKError); ok {
 		for _, code := range expectCodes {
 			// The second statement aims to match the tea sdk history bug
@@ -216,6 +315,11 @@ func NeedRetry(err error) bool {
 		return false
 	}
 
+	if IsThrottling(err) {
+		connectivity.ThrottlingBackoff.Wait()
+		return true
+	}
+
 	postRegex := regexp.MustCompile("^Post [\"]*https://.*")
 	if postRegex.MatchString(err.Error()) {
 		return true
@@ -252,6 +356,11 @@ func NoCodeRegexRetry(err error) bool {
 		return false
 	}
 
+	if IsThrottling(err) {
+		connectivity.ThrottlingBackoff.Wait()
+		return true
+	}
+
 	postRegex := regexp.MustCompile("^Post [\"]*https://.*")
 	if postRegex.MatchString(err.Error()) {
 		return true
@@ -284,6 +393,24 @@ func NoCodeRegexRetry(err error) bool {
 	return false
 }
 
+// IsThrottling returns whether the error is a throttling error, like Throttling.User and Rejected.Throttling.
+func IsThrottling(err error) bool {
+	throttlingRegex := regexp.MustCompile("Throttling")
+	if e, ok := err.(*ApiError); ok {
+		return IsThrottling(e.Cause)
+	}
+	if e, ok := err.(*tea.SDKError); ok && e.Code != nil {
+		return throttlingRegex.MatchString(*e.Code)
+	}
+	if e, ok := err.(*errors.ServerError); ok {
+		return throttlingRegex.MatchString(e.ErrorCode())
+	}
+	if e, ok := err.(*common.Error); ok {
+		return throttlingRegex.MatchString(e.Code)
+	}
+	return false
+}
+
 func IsExpectedErrorCodes(code string, errorCodes []string) bool {
 	if code == "" {
 		return false
@@ -341,6 +468,10 @@ type ComplexError struct {
 	Line  int
 }
 
+func (e ComplexError) Unwrap() error {
+	return e.Cause
+}
+
 func (e ComplexError) Error() string {
 	if e.Cause == nil {
 		e.Cause = Error("<nil cause>")
@@ -393,7 +524,11 @@ func WrapErrorf(cause error, msg string, args ...interface{}) error {
 	return WrapComplexError(cause, fmt.Errorf(msg, args...), filepath, line)
 }
 
+// WrapComplexError wraps the API errors of the SDKs as ApiError, so the code and request id are always surfaced.
 func WrapComplexError(cause, err error, filepath string, fileline int) error {
+	if apiErr := NewApiError(cause); apiErr != nil {
+		cause = apiErr
+	}
 	return &ComplexError{
 		Cause: cause,
 		Err:   err,
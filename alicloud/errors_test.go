@@ -0,0 +1,64 @@
+package alicloud
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alibabacloud-go/tea/tea"
+	sdkerrors "github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/denverdino/aliyungo/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitAliCloudApiError(t *testing.T) {
+	teaErr := tea.NewSDKError(map[string]interface{}{
+		"code":       "Throttling.User",
+		"message":    "Request was denied due to user flow control.",
+		"statusCode": 400,
+		"data": map[string]interface{}{
+			"RequestId": "4C467B38-3910-447D-87BC-AC049166F216",
+			"Recommend": "https://api.aliyun.com/troubleshoot?q=Throttling.User",
+		},
+	})
+	serverErr := sdkerrors.NewServerError(404, `{"Code":"InvalidInstanceId.NotFound","Message":"The instance is not found.","RequestId":"E2C3C3E6-4D39-4F70-A5D7-D5C2D2D4D36B","Recommend":"https://api.aliyun.com/troubleshoot?q=InvalidInstanceId.NotFound"}`, "")
+	commonErr := &common.Error{
+		ErrorResponse: common.ErrorResponse{
+			Response: common.Response{RequestId: "9F1B24D5-8F4A-4C2B-9E4B-6AE6C83D6D7C"},
+			Code:     "Forbidden.RAM",
+			Message:  "User not authorized to operate on the specified resource.",
+		},
+		StatusCode: 403,
+	}
+
+	cases := []struct {
+		err                           error
+		code, requestId, recommendUrl string
+		httpStatus                    int
+		notFound, throttling          bool
+	}{
+		{teaErr, "Throttling.User", "4C467B38-3910-447D-87BC-AC049166F216", "https://api.aliyun.com/troubleshoot?q=Throttling.User", 400, false, true},
+		{serverErr, "InvalidInstanceId.NotFound", "E2C3C3E6-4D39-4F70-A5D7-D5C2D2D4D36B", "https://api.aliyun.com/troubleshoot?q=InvalidInstanceId.NotFound", 404, true, false},
+		{commonErr, "Forbidden.RAM", "9F1B24D5-8F4A-4C2B-9E4B-6AE6C83D6D7C", "", 403, false, false},
+	}
+	for _, c := range cases {
+		err := WrapErrorf(c.err, DefaultErrorMsg, "i-abc", "DescribeInstances", AlibabaCloudSdkGoERROR)
+		var apiErr *ApiError
+		if !assert.True(t, errors.As(err, &apiErr)) {
+			continue
+		}
+		assert.Equal(t, c.code, apiErr.Code)
+		assert.Equal(t, c.requestId, apiErr.RequestId)
+		assert.Equal(t, c.httpStatus, apiErr.HttpStatus)
+		assert.Equal(t, c.recommendUrl, apiErr.RecommendUrl)
+		assert.Equal(t, c.code, ApiErrorCode(err))
+		assert.True(t, errors.Is(err, c.err))
+		assert.Contains(t, err.Error(), c.requestId)
+		assert.True(t, IsExpectedErrors(err, []string{c.code}))
+		assert.Equal(t, c.notFound, NotFoundError(err))
+		assert.Equal(t, c.throttling, IsThrottling(apiErr))
+	}
+
+	assert.Nil(t, NewApiError(errors.New("unknown error")))
+	assert.Equal(t, "", ApiErrorCode(WrapError(errors.New("unknown error"))))
+	assert.Equal(t, "Forbidden.RAM", ApiErrorCode(commonErr))
+}
@@ -0,0 +1,71 @@
+// Package framework contains the alicloud provider written against terraform-plugin-framework.
+//
+// It is served alongside the terraform-plugin-sdk provider in the package alicloud by the muxed
+// protocol v6 server, so the new resources, data sources and functions can use the framework
+// features, like provider-defined functions and nested attributes, while the existing ones are
+// kept unchanged. The provider arguments are owned and configured by the SDK provider, and the
+// configured *connectivity.AliyunClient is shared with the framework resources.
+package framework
+
+import (
+	"context"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ provider.ProviderWithFunctions = &alicloudProvider{}
+
+// MetaFunc returns the meta of the configured SDK provider, which is a *connectivity.AliyunClient.
+type MetaFunc func() interface{}
+
+type alicloudProvider struct {
+	meta MetaFunc
+}
+
+// New returns the framework provider. The meta function is called when the provider is being
+// configured, after the SDK provider has been configured.
+func New(meta MetaFunc) provider.Provider {
+	return &alicloudProvider{meta: meta}
+}
+
+func (p *alicloudProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
+	resp.TypeName = "alicloud"
+}
+
+// Schema is empty, the provider arguments are defined by the SDK provider. See NewProtocol6Server.
+func (p *alicloudProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
+}
+
+func (p *alicloudProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	var meta interface{}
+	if p.meta != nil {
+		meta = p.meta()
+	}
+	client, ok := meta.(*connectivity.AliyunClient)
+	if !ok || client == nil {
+		resp.Diagnostics.AddError("Unconfigured Provider", "The alicloud client has not been configured by the SDK provider. "+
+			"This is always an issue in the provider implementation and should be reported to the provider developers.")
+		return
+	}
+	resp.DataSourceData = client
+	resp.ResourceData = client
+}
+
+// Resources returns the resources written against the framework.
+func (p *alicloudProvider) Resources(ctx context.Context) []func() resource.Resource {
+	return []func() resource.Resource{}
+}
+
+// DataSources returns the data sources written against the framework.
+func (p *alicloudProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
+	return []func() datasource.DataSource{}
+}
+
+// Functions returns the provider-defined functions, which need Terraform 1.8 and later.
+func (p *alicloudProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{}
+}
@@ -0,0 +1,45 @@
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// NewProtocol6Server returns the protocol v6 server of the framework provider to be muxed after the
+// SDK provider server.
+//
+// The mux server requires the provider schemas of all of the servers to be identical. The framework
+// provider does not define the provider arguments, so its server reports no provider schema, skips
+// the validation of the provider configuration and is configured with an empty configuration. The
+// SDK provider server validates and configures the provider arguments instead.
+func NewProtocol6Server(meta MetaFunc) func() tfprotov6.ProviderServer {
+	server := providerserver.NewProtocol6(New(meta))
+	return func() tfprotov6.ProviderServer {
+		return &protocol6Server{ProviderServer: server()}
+	}
+}
+
+type protocol6Server struct {
+	tfprotov6.ProviderServer
+}
+
+func (s *protocol6Server) GetProviderSchema(ctx context.Context, req *tfprotov6.GetProviderSchemaRequest) (*tfprotov6.GetProviderSchemaResponse, error) {
+	resp, err := s.ProviderServer.GetProviderSchema(ctx, req)
+	if resp != nil {
+		resp.Provider = nil
+		resp.ProviderMeta = nil
+	}
+	return resp, err
+}
+
+func (s *protocol6Server) ValidateProviderConfig(ctx context.Context, req *tfprotov6.ValidateProviderConfigRequest) (*tfprotov6.ValidateProviderConfigResponse, error) {
+	return &tfprotov6.ValidateProviderConfigResponse{}, nil
+}
+
+func (s *protocol6Server) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	return s.ProviderServer.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		TerraformVersion: req.TerraformVersion,
+	})
+}
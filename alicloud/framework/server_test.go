@@ -0,0 +1,49 @@
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestNewProtocol6Server(t *testing.T) {
+	ctx := context.Background()
+	server := NewProtocol6Server(func() interface{} { return &connectivity.AliyunClient{} })()
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schemaResp.Provider != nil || schemaResp.ProviderMeta != nil {
+		t.Fatalf("the provider schema should be left to the SDK provider, got %v.", schemaResp.Provider)
+	}
+	if len(schemaResp.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics %v.", schemaResp.Diagnostics)
+	}
+
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{TerraformVersion: "1.8.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(configureResp.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics %v.", configureResp.Diagnostics)
+	}
+}
+
+func TestNewProtocol6ServerUnconfigured(t *testing.T) {
+	ctx := context.Background()
+	server := NewProtocol6Server(func() interface{} { return nil })()
+
+	if _, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{}); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Diagnostics) != 1 || resp.Diagnostics[0].Severity != tfprotov6.DiagnosticSeverityError {
+		t.Fatalf("the unconfigured client should be reported, got %v.", resp.Diagnostics)
+	}
+}
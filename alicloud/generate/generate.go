@@ -0,0 +1,346 @@
+// Package generate enumerates the existing resources of the accounts by the data sources of the provider,
+// and writes the import blocks and the skeleton configurations of the resources, which helps to adopt
+// Terraform on the existing accounts.
+package generate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ResourceSource is the data source to enumerate the ids of a resource type.
+type ResourceSource struct {
+	DataSource string
+	// IdsKey is the attribute of the data source exporting the ids. Default to ids.
+	IdsKey string
+}
+
+// ResourceSources are the resource types supported by the generation.
+var ResourceSources = map[string]ResourceSource{
+	"alicloud_instance":          {DataSource: "alicloud_instances"},
+	"alicloud_vpc":               {DataSource: "alicloud_vpcs"},
+	"alicloud_vswitch":           {DataSource: "alicloud_vswitches"},
+	"alicloud_security_group":    {DataSource: "alicloud_security_groups"},
+	"alicloud_slb_load_balancer": {DataSource: "alicloud_slb_load_balancers"},
+	"alicloud_db_instance":       {DataSource: "alicloud_db_instances"},
+}
+
+// Options are the options of the generation.
+type Options struct {
+	// Regions are the regions to enumerate the resources in. The resources of the regions other than the
+	// first one are imported with the provider aliases named by the regions.
+	Regions []string
+	// Types are the resource types to generate. Default to all of the ResourceSources.
+	Types []string
+	// StateFile is the state file whose resources are skipped.
+	StateFile string
+}
+
+// Generate writes the import blocks and the skeleton configurations of the existing resources into w.
+func Generate(ctx context.Context, w io.Writer, opts Options) error {
+	if len(opts.Regions) == 0 {
+		return fmt.Errorf("at least one region is required")
+	}
+	types := opts.Types
+	if len(types) == 0 {
+		for resourceType := range ResourceSources {
+			types = append(types, resourceType)
+		}
+	}
+	sort.Strings(types)
+	for _, resourceType := range types {
+		if _, ok := ResourceSources[resourceType]; !ok {
+			return fmt.Errorf("the resource type %s is not supported, the supported types: %s", resourceType, strings.Join(supportedTypes(), ", "))
+		}
+	}
+	managed, err := managedIds(opts.StateFile)
+	if err != nil {
+		return err
+	}
+
+	file := hclwrite.NewEmptyFile()
+	body := file.Body()
+	labels := make(map[string]bool)
+	for i, region := range opts.Regions {
+		alias := ""
+		if i > 0 {
+			alias = strings.ReplaceAll(region, "-", "_")
+			provider := body.AppendNewBlock("provider", []string{"alicloud"}).Body()
+			provider.SetAttributeValue("alias", cty.StringVal(alias))
+			provider.SetAttributeValue("region", cty.StringVal(region))
+			body.AppendNewline()
+		}
+		p := alicloud.Provider()
+		if diags := p.Configure(ctx, terraform.NewResourceConfigRaw(map[string]interface{}{"region": region})); diags.HasError() {
+			return fmt.Errorf("configuring the provider in the region %s got an error: %v", region, diags)
+		}
+		for _, resourceType := range types {
+			ids, err := listIds(ctx, p, ResourceSources[resourceType])
+			if err != nil {
+				return fmt.Errorf("listing the %s in the region %s got an error: %v", resourceType, region, err)
+			}
+			for _, id := range ids {
+				if managed[resourceType+"/"+id] {
+					log.Printf("[INFO] skipping the %s %s which is in the state file.", resourceType, id)
+					continue
+				}
+				label := uniqueLabel(labels, resourceType, id)
+				writeImportBlock(body, resourceType, label, id, alias)
+				if err := writeResourceBlock(ctx, body, p, resourceType, label, id, alias); err != nil {
+					log.Printf("[WARN] reading the %s %s got an error, and its configuration is left empty: %v", resourceType, id, err)
+				}
+			}
+		}
+	}
+	_, err = w.Write(file.Bytes())
+	return err
+}
+
+func supportedTypes() []string {
+	types := make([]string, 0, len(ResourceSources))
+	for resourceType := range ResourceSources {
+		types = append(types, resourceType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// managedIds returns the ids of the resources in the state file, keyed by the type and id.
+func managedIds(stateFile string) (map[string]bool, error) {
+	managed := make(map[string]bool)
+	if stateFile == "" {
+		return managed, nil
+	}
+	data, err := ioutil.ReadFile(stateFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading the state file %s got an error: %v", stateFile, err)
+	}
+	var state struct {
+		Resources []struct {
+			Mode      string `json:"mode"`
+			Type      string `json:"type"`
+			Instances []struct {
+				Attributes map[string]interface{} `json:"attributes"`
+			} `json:"instances"`
+		} `json:"resources"`
+	}
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf("parsing the state file %s got an error: %v", stateFile, err)
+	}
+	for _, r := range state.Resources {
+		if r.Mode != "managed" {
+			continue
+		}
+		for _, instance := range r.Instances {
+			if id, ok := instance.Attributes["id"].(string); ok && id != "" {
+				managed[r.Type+"/"+id] = true
+			}
+		}
+	}
+	return managed, nil
+}
+
+func listIds(ctx context.Context, p *schema.Provider, source ResourceSource) ([]string, error) {
+	dataSource, ok := p.DataSourcesMap[source.DataSource]
+	if !ok {
+		return nil, fmt.Errorf("the data source %s is not found", source.DataSource)
+	}
+	d := dataSource.Data(nil)
+	if err := readResource(ctx, dataSource, d, p.Meta()); err != nil {
+		return nil, err
+	}
+	idsKey := source.IdsKey
+	if idsKey == "" {
+		idsKey = "ids"
+	}
+	var ids []string
+	for _, id := range d.Get(idsKey).([]interface{}) {
+		ids = append(ids, fmt.Sprint(id))
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+func readResource(ctx context.Context, r *schema.Resource, d *schema.ResourceData, meta interface{}) error {
+	switch {
+	case r.Read != nil:
+		return r.Read(d, meta)
+	case r.ReadContext != nil:
+		if diags := r.ReadContext(ctx, d, meta); diags.HasError() {
+			return fmt.Errorf("%v", diags)
+		}
+	case r.ReadWithoutTimeout != nil:
+		if diags := r.ReadWithoutTimeout(ctx, d, meta); diags.HasError() {
+			return fmt.Errorf("%v", diags)
+		}
+	}
+	return nil
+}
+
+var invalidLabelRegex = regexp.MustCompile(`[^a-zA-Z0-9_]`)
+
+func uniqueLabel(labels map[string]bool, resourceType, id string) string {
+	label := invalidLabelRegex.ReplaceAllString(id, "_")
+	if label == "" || (label[0] >= '0' && label[0] <= '9') {
+		label = "r_" + label
+	}
+	unique := label
+	for i := 2; labels[resourceType+"."+unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	labels[resourceType+"."+unique] = true
+	return unique
+}
+
+func writeImportBlock(body *hclwrite.Body, resourceType, label, id, alias string) {
+	block := body.AppendNewBlock("import", nil).Body()
+	block.SetAttributeTraversal("to", traversal(resourceType, label))
+	block.SetAttributeValue("id", cty.StringVal(id))
+	if alias != "" {
+		block.SetAttributeTraversal("provider", traversal("alicloud", alias))
+	}
+	body.AppendNewline()
+}
+
+// writeResourceBlock writes the skeleton configuration of the resource. The attributes which can be configured
+// are filled with the values read by the resource, and the nested blocks and the sensitive attributes are left
+// to be completed by hand.
+func writeResourceBlock(ctx context.Context, body *hclwrite.Body, p *schema.Provider, resourceType, label, id, alias string) error {
+	r := p.ResourcesMap[resourceType]
+	block := body.AppendNewBlock("resource", []string{resourceType, label}).Body()
+	defer body.AppendNewline()
+	if alias != "" {
+		block.SetAttributeTraversal("provider", traversal("alicloud", alias))
+	}
+
+	d := r.Data(nil)
+	d.SetId(id)
+	if r.Importer != nil {
+		var imported []*schema.ResourceData
+		var err error
+		if r.Importer.StateContext != nil {
+			imported, err = r.Importer.StateContext(ctx, d, p.Meta())
+		} else if r.Importer.State != nil {
+			imported, err = r.Importer.State(d, p.Meta())
+		}
+		if err != nil {
+			return err
+		}
+		if len(imported) > 0 {
+			d = imported[0]
+		}
+	}
+	if err := readResource(ctx, r, d, p.Meta()); err != nil {
+		return err
+	}
+	if d.Id() == "" {
+		return fmt.Errorf("the resource is not found")
+	}
+
+	keys := make([]string, 0, len(r.Schema))
+	for key := range r.Schema {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		s := r.Schema[key]
+		if !s.Required && !s.Optional || s.Deprecated != "" {
+			continue
+		}
+		if s.Sensitive {
+			if s.Required {
+				block.AppendUnstructuredTokens(comment(fmt.Sprintf("# %s is sensitive and required", key)))
+			}
+			continue
+		}
+		if _, ok := s.Elem.(*schema.Resource); ok {
+			if s.Required {
+				block.AppendUnstructuredTokens(comment(fmt.Sprintf("# %s blocks are required", key)))
+			}
+			continue
+		}
+		v, ok := d.GetOk(key)
+		if !ok {
+			continue
+		}
+		value, ok := ctyValue(s, v)
+		if !ok {
+			continue
+		}
+		block.SetAttributeValue(key, value)
+	}
+	return nil
+}
+
+func ctyValue(s *schema.Schema, v interface{}) (cty.Value, bool) {
+	switch s.Type {
+	case schema.TypeString:
+		return cty.StringVal(v.(string)), true
+	case schema.TypeInt:
+		return cty.NumberIntVal(int64(v.(int))), true
+	case schema.TypeFloat:
+		return cty.NumberFloatVal(v.(float64)), true
+	case schema.TypeBool:
+		return cty.BoolVal(v.(bool)), true
+	case schema.TypeList, schema.TypeSet:
+		elem, ok := s.Elem.(*schema.Schema)
+		if !ok {
+			return cty.NilVal, false
+		}
+		items := v
+		if set, ok := v.(*schema.Set); ok {
+			items = set.List()
+		}
+		var values []cty.Value
+		for _, item := range items.([]interface{}) {
+			value, ok := ctyValue(elem, item)
+			if !ok {
+				return cty.NilVal, false
+			}
+			values = append(values, value)
+		}
+		if len(values) == 0 {
+			return cty.NilVal, false
+		}
+		return cty.TupleVal(values), true
+	case schema.TypeMap:
+		values := make(map[string]cty.Value)
+		for k, item := range v.(map[string]interface{}) {
+			values[k] = cty.StringVal(fmt.Sprint(item))
+		}
+		if len(values) == 0 {
+			return cty.NilVal, false
+		}
+		return cty.ObjectVal(values), true
+	}
+	return cty.NilVal, false
+}
+
+func traversal(names ...string) hcl.Traversal {
+	t := hcl.Traversal{hcl.TraverseRoot{Name: names[0]}}
+	for _, name := range names[1:] {
+		t = append(t, hcl.TraverseAttr{Name: name})
+	}
+	return t
+}
+
+func comment(text string) hclwrite.Tokens {
+	return hclwrite.Tokens{
+		{Type: hclsyntax.TokenComment, Bytes: []byte(text + "\n")},
+	}
+}
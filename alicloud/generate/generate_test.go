@@ -0,0 +1,98 @@
+package generate
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceSources(t *testing.T) {
+	p := alicloud.Provider()
+	for resourceType, source := range ResourceSources {
+		r, ok := p.ResourcesMap[resourceType]
+		if !ok {
+			t.Errorf("the resource %s is not found.", resourceType)
+			continue
+		}
+		if r.Importer == nil {
+			t.Errorf("the resource %s can not be imported.", resourceType)
+		}
+		dataSource, ok := p.DataSourcesMap[source.DataSource]
+		if !ok {
+			t.Errorf("the data source %s of the resource %s is not found.", source.DataSource, resourceType)
+			continue
+		}
+		idsKey := source.IdsKey
+		if idsKey == "" {
+			idsKey = "ids"
+		}
+		if s, ok := dataSource.Schema[idsKey]; !ok || s.Type != schema.TypeList {
+			t.Errorf("the data source %s should export the ids by the list %s.", source.DataSource, idsKey)
+		}
+	}
+}
+
+func TestManagedIds(t *testing.T) {
+	stateFile := filepath.Join(t.TempDir(), "terraform.tfstate")
+	state := `{
+  "version": 4,
+  "resources": [
+    {"mode": "managed", "type": "alicloud_vpc", "name": "default", "instances": [{"attributes": {"id": "vpc-abc"}}]},
+    {"mode": "data", "type": "alicloud_vpcs", "name": "default", "instances": [{"attributes": {"id": "123"}}]}
+  ]
+}`
+	if err := os.WriteFile(stateFile, []byte(state), 0600); err != nil {
+		t.Fatal(err)
+	}
+	managed, err := managedIds(stateFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(managed) != 1 || !managed["alicloud_vpc/vpc-abc"] {
+		t.Fatalf("only the managed resources should be skipped, got %v.", managed)
+	}
+	if _, err := managedIds(filepath.Join(t.TempDir(), "missing.tfstate")); err == nil {
+		t.Fatal("the missing state file should be reported.")
+	}
+}
+
+func TestWriteImportBlock(t *testing.T) {
+	labels := make(map[string]bool)
+	file := hclwrite.NewEmptyFile()
+	writeImportBlock(file.Body(), "alicloud_vpc", uniqueLabel(labels, "alicloud_vpc", "vpc-abc"), "vpc-abc", "")
+	writeImportBlock(file.Body(), "alicloud_vpc", uniqueLabel(labels, "alicloud_vpc", "vpc_abc"), "vpc_abc", "cn_beijing")
+	writeImportBlock(file.Body(), "alicloud_db_instance", uniqueLabel(labels, "alicloud_db_instance", "1234:rm"), "1234:rm", "")
+
+	output := string(file.Bytes())
+	for _, expected := range []string{
+		"to = alicloud_vpc.vpc_abc\n",
+		"to       = alicloud_vpc.vpc_abc_2\n",
+		"provider = alicloud.cn_beijing\n",
+		"to = alicloud_db_instance.r_1234_rm\n",
+		`id = "1234:rm"`,
+	} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("the import blocks should contain %q, got:\n%s", expected, output)
+		}
+	}
+}
+
+func TestCtyValue(t *testing.T) {
+	stringList := &schema.Schema{Type: schema.TypeList, Elem: &schema.Schema{Type: schema.TypeString}}
+	if v, ok := ctyValue(stringList, []interface{}{"a", "b"}); !ok || v.LengthInt() != 2 {
+		t.Fatalf("the list of strings should be converted, got %#v.", v)
+	}
+	blocks := &schema.Schema{Type: schema.TypeList, Elem: &schema.Resource{}}
+	if _, ok := ctyValue(blocks, []interface{}{map[string]interface{}{}}); ok {
+		t.Fatal("the nested blocks should not be converted.")
+	}
+	tags := &schema.Schema{Type: schema.TypeMap}
+	if v, ok := ctyValue(tags, map[string]interface{}{"Created": "tf"}); !ok || v.GetAttr("Created").AsString() != "tf" {
+		t.Fatalf("the map should be converted, got %#v.", v)
+	}
+}
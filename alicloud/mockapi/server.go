@@ -0,0 +1,298 @@
+// Package mockapi provides an in-process fake of the Alibaba Cloud OpenAPI RPC and ROA protocols.
+//
+// The tests register the handlers of the api actions on a Server, and point the provider
+// endpoints block at Server.Endpoint with the HTTP protocol. The requests are routed by the
+// api version and action for the RPC style, and by the method and path for the ROA style.
+// The signatures are never checked. The handlers are run one at a time, so they can keep
+// their state in plain maps.
+package mockapi
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Request is an api request received by the server.
+type Request struct {
+	// Action and Version are set for the RPC style requests.
+	Action  string
+	Version string
+	Method  string
+	Path    string
+	// Params are the query and the form body parameters.
+	Params map[string]string
+	// PathParams are the values of the {name} segments of the ROA path pattern.
+	PathParams map[string]string
+	Headers    http.Header
+	// Body is the raw body of the ROA style requests.
+	Body []byte
+}
+
+// Param returns the request parameter, or an empty string if it is not set.
+func (r *Request) Param(key string) string {
+	return r.Params[key]
+}
+
+// ParamList returns the values of the repeated parameters like Tag.1.Key and Tag.2.Key,
+// where prefix is "Tag" and suffix is "Key", in index order.
+func (r *Request) ParamList(prefix, suffix string) []string {
+	result := make([]string, 0)
+	for i := 1; ; i++ {
+		key := fmt.Sprintf("%s.%d", prefix, i)
+		if suffix != "" {
+			key += "." + suffix
+		}
+		v, ok := r.Params[key]
+		if !ok {
+			return result
+		}
+		result = append(result, v)
+	}
+}
+
+// Handler handles an api request. The returned value is encoded as the response body,
+// and a RequestId is added to it if it is a map. The returned *Error is encoded as the
+// standard error envelope.
+type Handler func(r *Request) (interface{}, error)
+
+// Error is the standard error of the OpenAPI.
+type Error struct {
+	HttpStatus int
+	Code       string
+	Message    string
+	Recommend  string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.HttpStatus, e.Code, e.Message)
+}
+
+// NewError returns an error with the http status 400.
+func NewError(code, message string) *Error {
+	return &Error{HttpStatus: http.StatusBadRequest, Code: code, Message: message}
+}
+
+// NotFoundError returns an error with the http status 404.
+func NotFoundError(code, message string) *Error {
+	return &Error{HttpStatus: http.StatusNotFound, Code: code, Message: message}
+}
+
+type roaRoute struct {
+	method   string
+	segments []string
+	handler  Handler
+}
+
+// Server is the fake OpenAPI server.
+type Server struct {
+	// URL is the base url like http://127.0.0.1:8080.
+	URL string
+	// Endpoint is the host and port used in the provider endpoints block.
+	Endpoint string
+
+	server    *httptest.Server
+	mutex     sync.Mutex
+	rpcRoutes map[string]Handler
+	roaRoutes []roaRoute
+	calls     map[string]int
+	requestId int64
+}
+
+// NewServer starts a server. It should be closed by Close.
+func NewServer() *Server {
+	s := &Server{
+		rpcRoutes: make(map[string]Handler),
+		calls:     make(map[string]int),
+	}
+	s.server = httptest.NewServer(s)
+	s.URL = s.server.URL
+	s.Endpoint = strings.TrimPrefix(s.server.URL, "http://")
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// HandleRpc registers the handler of the RPC style api action. An empty version matches all versions.
+func (s *Server) HandleRpc(version, action string, handler Handler) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.rpcRoutes[version+"/"+action] = handler
+}
+
+// HandleRoa registers the handler of the ROA style api. The pattern is a path like
+// /clusters/{ClusterId}/nodepools, where the {name} segments match any value.
+func (s *Server) HandleRoa(method, pattern string, handler Handler) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.roaRoutes = append(s.roaRoutes, roaRoute{
+		method:   strings.ToUpper(method),
+		segments: strings.Split(strings.Trim(pattern, "/"), "/"),
+		handler:  handler,
+	})
+}
+
+// Calls returns how many times the RPC action, or the ROA "METHOD /path" has been called.
+func (s *Server) Calls(action string) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.calls[action]
+}
+
+// ServeHTTP routes the request to its handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+	r := &Request{
+		Method:     req.Method,
+		Path:       req.URL.Path,
+		Params:     make(map[string]string),
+		PathParams: make(map[string]string),
+		Headers:    req.Header,
+		Body:       body,
+	}
+	for k, v := range req.URL.Query() {
+		r.Params[k] = v[0]
+	}
+	if strings.Contains(req.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		if values, err := url.ParseQuery(string(body)); err == nil {
+			for k, v := range values {
+				r.Params[k] = v[0]
+			}
+		}
+	}
+	r.Action = r.Params["Action"]
+	r.Version = r.Params["Version"]
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	requestId := fmt.Sprintf("MOCK-%08d", atomic.AddInt64(&s.requestId, 1))
+	format := strings.ToLower(r.Params["Format"])
+
+	var handler Handler
+	if r.Action != "" {
+		s.calls[r.Action]++
+		handler = s.rpcRoutes[r.Version+"/"+r.Action]
+		if handler == nil {
+			handler = s.rpcRoutes["/"+r.Action]
+		}
+		if handler == nil {
+			writeResponse(w, format, requestId, nil, NotFoundError("InvalidAction.NotFound", fmt.Sprintf("Specified api %s of version %s is not found.", r.Action, r.Version)))
+			return
+		}
+	} else {
+		s.calls[req.Method+" "+req.URL.Path]++
+		handler = s.matchRoa(r)
+		if handler == nil {
+			writeResponse(w, format, requestId, nil, NotFoundError("InvalidAction.NotFound", fmt.Sprintf("Specified api %s %s is not found.", req.Method, req.URL.Path)))
+			return
+		}
+	}
+	result, err := handler(r)
+	writeResponse(w, format, requestId, result, err)
+}
+
+func (s *Server) matchRoa(r *Request) Handler {
+	segments := strings.Split(strings.Trim(r.Path, "/"), "/")
+	for _, route := range s.roaRoutes {
+		if route.method != r.Method || len(route.segments) != len(segments) {
+			continue
+		}
+		params := make(map[string]string)
+		matched := true
+		for i, segment := range route.segments {
+			if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+				params[strings.Trim(segment, "{}")] = segments[i]
+				continue
+			}
+			if segment != segments[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			r.PathParams = params
+			return route.handler
+		}
+	}
+	return nil
+}
+
+func writeResponse(w http.ResponseWriter, format, requestId string, result interface{}, err error) {
+	status := http.StatusOK
+	if err != nil {
+		e, ok := err.(*Error)
+		if !ok {
+			e = &Error{HttpStatus: http.StatusInternalServerError, Code: "InternalError", Message: err.Error()}
+		}
+		status = e.HttpStatus
+		result = map[string]interface{}{
+			"RequestId": requestId,
+			"HostId":    "mockapi",
+			"Code":      e.Code,
+			"Message":   e.Message,
+			"Recommend": e.Recommend,
+		}
+	} else if m, ok := result.(map[string]interface{}); ok {
+		if _, exist := m["RequestId"]; !exist {
+			m["RequestId"] = requestId
+		}
+	}
+	if result == nil {
+		result = map[string]interface{}{"RequestId": requestId}
+	}
+
+	w.Header().Set("x-acs-request-id", requestId)
+	if format == "xml" {
+		w.Header().Set("Content-Type", "application/xml;charset=utf-8")
+		w.WriteHeader(status)
+		encoder := xml.NewEncoder(w)
+		encodeXML(encoder, "Response", result)
+		encoder.Flush()
+		return
+	}
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(result)
+}
+
+// encodeXML encodes the maps as the elements and the lists as the repeated elements.
+func encodeXML(encoder *xml.Encoder, name string, value interface{}) {
+	switch v := value.(type) {
+	case []interface{}:
+		for _, item := range v {
+			encodeXML(encoder, name, item)
+		}
+		return
+	case []map[string]interface{}:
+		for _, item := range v {
+			encodeXML(encoder, name, item)
+		}
+		return
+	}
+	start := xml.StartElement{Name: xml.Name{Local: name}}
+	encoder.EncodeToken(start)
+	if m, ok := value.(map[string]interface{}); ok {
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			encodeXML(encoder, k, m[k])
+		}
+	} else if value != nil {
+		encoder.EncodeToken(xml.CharData(fmt.Sprint(value)))
+	}
+	encoder.EncodeToken(start.End())
+}
@@ -0,0 +1,91 @@
+package mockapi
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	rpc "github.com/alibabacloud-go/tea-rpc/client"
+	util "github.com/alibabacloud-go/tea-utils/service"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+func newTestRpcClient(t *testing.T, s *Server) *rpc.Client {
+	config := &rpc.Config{}
+	config.SetAccessKeyId("mock").SetAccessKeySecret("mock").SetRegionId("cn-hangzhou").SetProtocol("HTTP").SetEndpoint(s.Endpoint)
+	conn, err := rpc.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn
+}
+
+func TestServerRpc(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	vpcs := map[string]string{}
+	s.HandleRpc("2016-04-28", "CreateVpc", func(r *Request) (interface{}, error) {
+		vpcs["vpc-mock"] = r.Param("VpcName")
+		return map[string]interface{}{"VpcId": "vpc-mock"}, nil
+	})
+	s.HandleRpc("2016-04-28", "DeleteVpc", func(r *Request) (interface{}, error) {
+		return nil, NewError("DependencyViolation.VSwitch", "The specified VPC has vswitches.")
+	})
+
+	conn := newTestRpcClient(t, s)
+	runtime := &util.RuntimeOptions{}
+	response, err := conn.DoRequest(tea.String("CreateVpc"), nil, tea.String("POST"), tea.String("2016-04-28"), tea.String("AK"), nil, map[string]interface{}{"VpcName": "tf-test"}, runtime)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response["VpcId"] != "vpc-mock" || response["RequestId"] == nil || vpcs["vpc-mock"] != "tf-test" {
+		t.Fatalf("unexpected response %v and state %v.", response, vpcs)
+	}
+
+	_, err = conn.DoRequest(tea.String("DeleteVpc"), nil, tea.String("POST"), tea.String("2016-04-28"), tea.String("AK"), nil, nil, runtime)
+	if e, ok := err.(*tea.SDKError); !ok || tea.StringValue(e.Code) != "DependencyViolation.VSwitch" || tea.IntValue(e.StatusCode) != 400 {
+		t.Fatalf("expected the standard error envelope, got %#v.", err)
+	}
+
+	_, err = conn.DoRequest(tea.String("DescribeVpcs"), nil, tea.String("POST"), tea.String("2016-04-28"), tea.String("AK"), nil, nil, runtime)
+	if e, ok := err.(*tea.SDKError); !ok || tea.IntValue(e.StatusCode) != 404 {
+		t.Fatalf("expected the not found error of the unknown action, got %#v.", err)
+	}
+	if s.Calls("CreateVpc") != 1 || s.Calls("DescribeVpcs") != 1 {
+		t.Fatal("the calls should be counted.")
+	}
+}
+
+func TestServerRoaAndXml(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	s.HandleRoa("GET", "/clusters/{ClusterId}/nodepools", func(r *Request) (interface{}, error) {
+		return map[string]interface{}{
+			"nodepools": []interface{}{map[string]interface{}{"cluster_id": r.PathParams["ClusterId"]}},
+		}, nil
+	})
+	s.HandleRpc("", "DescribeRegions", func(r *Request) (interface{}, error) {
+		return map[string]interface{}{"Regions": map[string]interface{}{"Region": []interface{}{"cn-hangzhou", "cn-beijing"}}}, nil
+	})
+
+	resp, err := http.Get(s.URL + "/clusters/c-mock/nodepools")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(body), `"cluster_id":"c-mock"`) {
+		t.Fatalf("the path parameter should be passed to the handler, got %s.", body)
+	}
+
+	resp, err = http.Get(s.URL + "/?Action=DescribeRegions&Version=2014-05-26&Format=XML")
+	if err != nil {
+		t.Fatal(err)
+	}
+	body, _ = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if !strings.Contains(string(body), "<Region>cn-hangzhou</Region><Region>cn-beijing</Region>") {
+		t.Fatalf("the response should be encoded as xml, got %s.", body)
+	}
+}
@@ -0,0 +1,330 @@
+package alicloud
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/mockapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// mockVpcBackend is a stateful fake of the VPC and VSwitch apis used by alicloud_vpc and alicloud_vswitch.
+type mockVpcBackend struct {
+	vpcs      map[string]map[string]interface{}
+	vswitches map[string]map[string]interface{}
+	sequence  int
+}
+
+func newMockVpcBackend(s *mockapi.Server) *mockVpcBackend {
+	b := &mockVpcBackend{
+		vpcs:      make(map[string]map[string]interface{}),
+		vswitches: make(map[string]map[string]interface{}),
+	}
+	version := "2016-04-28"
+	s.HandleRpc(version, "CreateVpc", b.createVpc)
+	s.HandleRpc(version, "DescribeVpcAttribute", b.describeVpcAttribute)
+	s.HandleRpc(version, "DescribeRouteTableList", b.describeRouteTableList)
+	s.HandleRpc(version, "ModifyVpcAttribute", b.modifyVpcAttribute)
+	s.HandleRpc(version, "DeleteVpc", b.deleteVpc)
+	s.HandleRpc(version, "CreateVSwitch", b.createVSwitch)
+	s.HandleRpc(version, "DescribeVSwitchAttributes", b.describeVSwitchAttributes)
+	s.HandleRpc(version, "ModifyVSwitchAttribute", b.modifyVSwitchAttribute)
+	s.HandleRpc(version, "DeleteVSwitch", b.deleteVSwitch)
+	s.HandleRpc(version, "TagResources", b.tagResources)
+	s.HandleRpc(version, "UnTagResources", b.untagResources)
+	s.HandleRpc(version, "UntagResources", b.untagResources)
+	return b
+}
+
+func (b *mockVpcBackend) nextId(prefix string) string {
+	b.sequence++
+	return fmt.Sprintf("%s-mock%06d", prefix, b.sequence)
+}
+
+func (b *mockVpcBackend) resource(id string) map[string]interface{} {
+	if v, ok := b.vpcs[id]; ok {
+		return v
+	}
+	return b.vswitches[id]
+}
+
+func mockTags(r *mockapi.Request) map[string]interface{} {
+	tags := make(map[string]interface{})
+	values := r.ParamList("Tag", "Value")
+	for i, key := range r.ParamList("Tag", "Key") {
+		if i < len(values) {
+			tags[key] = values[i]
+		}
+	}
+	return tags
+}
+
+func mockTagsResponse(tags interface{}) map[string]interface{} {
+	items := make([]interface{}, 0)
+	for key, value := range tags.(map[string]interface{}) {
+		items = append(items, map[string]interface{}{"Key": key, "Value": value})
+	}
+	return map[string]interface{}{"Tag": items}
+}
+
+func (b *mockVpcBackend) createVpc(r *mockapi.Request) (interface{}, error) {
+	id := b.nextId("vpc")
+	cidrBlock := r.Param("CidrBlock")
+	if cidrBlock == "" {
+		cidrBlock = "172.16.0.0/12"
+	}
+	b.vpcs[id] = map[string]interface{}{
+		"VpcId":              id,
+		"VpcName":            r.Param("VpcName"),
+		"Description":        r.Param("Description"),
+		"CidrBlock":          cidrBlock,
+		"RegionId":           r.Param("RegionId"),
+		"Status":             "Available",
+		"VRouterId":          b.nextId("vrt"),
+		"RouteTableId":       b.nextId("vtb"),
+		"ResourceGroupId":    "rg-mock",
+		"CreationTime":       time.Now().UTC().Format("2006-01-02T15:04:05Z"),
+		"DnsHostnameStatus":  "DISABLED",
+		"ClassicLinkEnabled": false,
+		"EnabledIpv6":        false,
+		"Tags":               mockTags(r),
+	}
+	return map[string]interface{}{"VpcId": id, "VRouterId": b.vpcs[id]["VRouterId"], "RouteTableId": b.vpcs[id]["RouteTableId"]}, nil
+}
+
+func (b *mockVpcBackend) describeVpcAttribute(r *mockapi.Request) (interface{}, error) {
+	vpc, ok := b.vpcs[r.Param("VpcId")]
+	if !ok {
+		return map[string]interface{}{"VpcId": ""}, nil
+	}
+	response := make(map[string]interface{})
+	for k, v := range vpc {
+		response[k] = v
+	}
+	response["Tags"] = mockTagsResponse(vpc["Tags"])
+	return response, nil
+}
+
+func (b *mockVpcBackend) describeRouteTableList(r *mockapi.Request) (interface{}, error) {
+	routeTables := make([]interface{}, 0)
+	if vpc, ok := b.vpcs[r.Param("VpcId")]; ok {
+		routeTables = append(routeTables, map[string]interface{}{
+			"VpcId":           vpc["VpcId"],
+			"RouteTableId":    vpc["RouteTableId"],
+			"RouterId":        vpc["VRouterId"],
+			"RouteTableType":  "System",
+			"RouteTableName":  "",
+			"Description":     "",
+			"ResourceGroupId": vpc["ResourceGroupId"],
+		})
+	}
+	return map[string]interface{}{"RouterTableList": map[string]interface{}{"RouterTableListType": routeTables}}, nil
+}
+
+func (b *mockVpcBackend) modifyVpcAttribute(r *mockapi.Request) (interface{}, error) {
+	vpc, ok := b.vpcs[r.Param("VpcId")]
+	if !ok {
+		return nil, mockapi.NotFoundError("InvalidVpcId.NotFound", "Specified VPC does not exist.")
+	}
+	for _, key := range []string{"VpcName", "Description"} {
+		if v, ok := r.Params[key]; ok {
+			vpc[key] = v
+		}
+	}
+	return nil, nil
+}
+
+func (b *mockVpcBackend) deleteVpc(r *mockapi.Request) (interface{}, error) {
+	id := r.Param("VpcId")
+	if _, ok := b.vpcs[id]; !ok {
+		return nil, mockapi.NotFoundError("InvalidVpcId.NotFound", "Specified VPC does not exist.")
+	}
+	for _, vsw := range b.vswitches {
+		if vsw["VpcId"] == id {
+			return nil, mockapi.NewError("DependencyViolation.VSwitch", "Specified VPC has vswitches.")
+		}
+	}
+	delete(b.vpcs, id)
+	return nil, nil
+}
+
+func (b *mockVpcBackend) createVSwitch(r *mockapi.Request) (interface{}, error) {
+	if _, ok := b.vpcs[r.Param("VpcId")]; !ok {
+		return nil, mockapi.NotFoundError("InvalidVpcId.NotFound", "Specified VPC does not exist.")
+	}
+	id := b.nextId("vsw")
+	b.vswitches[id] = map[string]interface{}{
+		"VSwitchId":    id,
+		"VSwitchName":  r.Param("VSwitchName"),
+		"Description":  r.Param("Description"),
+		"CidrBlock":    r.Param("CidrBlock"),
+		"VpcId":        r.Param("VpcId"),
+		"ZoneId":       r.Param("ZoneId"),
+		"Status":       "Available",
+		"CreationTime": time.Now().UTC().Format("2006-01-02T15:04:05Z"),
+		"Tags":         map[string]interface{}{},
+	}
+	return map[string]interface{}{"VSwitchId": id}, nil
+}
+
+func (b *mockVpcBackend) describeVSwitchAttributes(r *mockapi.Request) (interface{}, error) {
+	vsw, ok := b.vswitches[r.Param("VSwitchId")]
+	if !ok {
+		return map[string]interface{}{"VSwitchId": ""}, nil
+	}
+	response := make(map[string]interface{})
+	for k, v := range vsw {
+		response[k] = v
+	}
+	response["Tags"] = mockTagsResponse(vsw["Tags"])
+	return response, nil
+}
+
+func (b *mockVpcBackend) modifyVSwitchAttribute(r *mockapi.Request) (interface{}, error) {
+	vsw, ok := b.vswitches[r.Param("VSwitchId")]
+	if !ok {
+		return nil, mockapi.NotFoundError("InvalidVSwitchId.NotFound", "Specified VSwitch does not exist.")
+	}
+	for _, key := range []string{"VSwitchName", "Description"} {
+		if v, ok := r.Params[key]; ok {
+			vsw[key] = v
+		}
+	}
+	return nil, nil
+}
+
+func (b *mockVpcBackend) deleteVSwitch(r *mockapi.Request) (interface{}, error) {
+	id := r.Param("VSwitchId")
+	if _, ok := b.vswitches[id]; !ok {
+		return nil, mockapi.NotFoundError("InvalidVSwitchId.NotFound", "Specified VSwitch does not exist.")
+	}
+	delete(b.vswitches, id)
+	return nil, nil
+}
+
+func (b *mockVpcBackend) tagResources(r *mockapi.Request) (interface{}, error) {
+	for _, id := range r.ParamList("ResourceId", "") {
+		res := b.resource(id)
+		if res == nil {
+			return nil, mockapi.NotFoundError("InvalidResourceId.NotFound", "Specified resource does not exist.")
+		}
+		for key, value := range mockTags(r) {
+			res["Tags"].(map[string]interface{})[key] = value
+		}
+	}
+	return nil, nil
+}
+
+func (b *mockVpcBackend) untagResources(r *mockapi.Request) (interface{}, error) {
+	for _, id := range r.ParamList("ResourceId", "") {
+		res := b.resource(id)
+		if res == nil {
+			return nil, mockapi.NotFoundError("InvalidResourceId.NotFound", "Specified resource does not exist.")
+		}
+		for _, key := range r.ParamList("TagKey", "") {
+			delete(res["Tags"].(map[string]interface{}), key)
+		}
+	}
+	return nil, nil
+}
+
+func (b *mockVpcBackend) checkDestroy(s *terraform.State) error {
+	if len(b.vpcs) > 0 || len(b.vswitches) > 0 {
+		return fmt.Errorf("the vpcs %v and vswitches %v still exist", b.vpcs, b.vswitches)
+	}
+	return nil
+}
+
+func testAccMockApiProviderConfig(s *mockapi.Server) string {
+	return fmt.Sprintf(`
+provider "alicloud" {
+  access_key             = "mock-access-key"
+  secret_key             = "mock-secret-key"
+  region                 = "cn-hangzhou"
+  account_type           = "Domestic"
+  protocol               = "HTTP"
+  skip_region_validation = true
+  endpoints {
+    vpc = "%s"
+  }
+}
+`, s.Endpoint)
+}
+
+func testAccMockApiVpcConfig(s *mockapi.Server, name, tag string) string {
+	return testAccMockApiProviderConfig(s) + fmt.Sprintf(`
+resource "alicloud_vpc" "default" {
+  vpc_name    = "%[1]s"
+  cidr_block  = "172.16.0.0/12"
+  description = "%[1]s"
+  tags = {
+    For = "%[2]s"
+  }
+}
+
+resource "alicloud_vswitch" "default" {
+  vpc_id       = alicloud_vpc.default.id
+  cidr_block   = "172.16.0.0/21"
+  zone_id      = "cn-hangzhou-h"
+  vswitch_name = "%[1]s"
+}
+`, name, tag)
+}
+
+func TestUnitAliCloudVpcMockApi(t *testing.T) {
+	// The test steps are run by the Terraform CLI.
+	if _, err := exec.LookPath("terraform"); err != nil && os.Getenv("TF_ACC_TERRAFORM_PATH") == "" {
+		t.Skip("the Terraform CLI is required, install it or set TF_ACC_TERRAFORM_PATH")
+	}
+	s := mockapi.NewServer()
+	defer s.Close()
+	backend := newMockVpcBackend(s)
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers:    testAccProviders,
+		CheckDestroy: backend.checkDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMockApiVpcConfig(s, "tf-testacc-mock", "create"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("alicloud_vpc.default", "vpc_name", "tf-testacc-mock"),
+					resource.TestCheckResourceAttr("alicloud_vpc.default", "status", "Available"),
+					resource.TestCheckResourceAttr("alicloud_vpc.default", "tags.%", "1"),
+					resource.TestCheckResourceAttr("alicloud_vpc.default", "tags.For", "create"),
+					resource.TestCheckResourceAttrSet("alicloud_vpc.default", "route_table_id"),
+					resource.TestCheckResourceAttrSet("alicloud_vpc.default", "router_id"),
+					resource.TestCheckResourceAttrPair("alicloud_vswitch.default", "vpc_id", "alicloud_vpc.default", "id"),
+					resource.TestCheckResourceAttr("alicloud_vswitch.default", "zone_id", "cn-hangzhou-h"),
+					resource.TestCheckResourceAttr("alicloud_vswitch.default", "status", "Available"),
+				),
+			},
+			{
+				Config: testAccMockApiVpcConfig(s, "tf-testacc-mock-update", "update"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("alicloud_vpc.default", "vpc_name", "tf-testacc-mock-update"),
+					resource.TestCheckResourceAttr("alicloud_vpc.default", "description", "tf-testacc-mock-update"),
+					resource.TestCheckResourceAttr("alicloud_vpc.default", "tags.For", "update"),
+					resource.TestCheckResourceAttr("alicloud_vswitch.default", "vswitch_name", "tf-testacc-mock-update"),
+				),
+			},
+			{
+				Config:                  testAccMockApiProviderConfig(s),
+				ResourceName:            "alicloud_vpc.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"dry_run", "enable_ipv6"},
+			},
+			{
+				Config:                  testAccMockApiProviderConfig(s),
+				ResourceName:            "alicloud_vswitch.default",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"enable_ipv6"},
+			},
+		},
+	})
+}
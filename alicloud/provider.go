@@ -12,22 +12,22 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/aliyun/credentials-go/credentials"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mitchellh/go-homedir"
 )
 
 // Provider returns a schema.Provider for alicloud
-func Provider() terraform.ResourceProvider {
+func Provider() *schema.Provider {
 	provider := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_key": {
@@ -164,12 +164,48 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ALICLOUD_CREDENTIALS_URI", "ALIBABA_CLOUD_CREDENTIALS_URI"}, nil),
 				Description: descriptions["credentials_uri"],
 			},
+			"credential_process": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.MultiEnvDefaultFunc([]string{"ALICLOUD_CREDENTIAL_PROCESS", "ALIBABA_CLOUD_CREDENTIAL_PROCESS"}, nil),
+				Description: descriptions["credential_process"],
+			},
 			"max_retry_timeout": {
 				Type:        schema.TypeInt,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("MAX_RETRY_TIMEOUT", 0),
 				Description: descriptions["max_retry_timeout"],
 			},
+			"default_tags": defaultTagsSchema(),
+			"ignore_tags":  ignoreTagsSchema(),
+			"rate_limit":   rateLimitSchema(),
+			"retry":        retrySchema(),
+			"trace_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_TRACE_FILE", ""),
+				Description: descriptions["trace_file"],
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ALICLOUD_ENDPOINT_TYPE", connectivity.EndpointTypePublic),
+				Description:  descriptions["endpoint_type"],
+				ValidateFunc: StringInSlice([]string{connectivity.EndpointTypePublic, connectivity.EndpointTypeVpc, connectivity.EndpointTypeIntranet}, false),
+			},
+			"endpoint_cache_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ALICLOUD_ENDPOINT_CACHE_FILE", ""),
+				Description: descriptions["endpoint_cache_file"],
+			},
+			"endpoint_cache_ttl": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ALICLOUD_ENDPOINT_CACHE_TTL", connectivity.DefaultEndpointCacheTTL),
+				Description:  descriptions["endpoint_cache_ttl"],
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"alicloud_gwlb_zones":             dataSourceAliCloudGwlbZones(),
@@ -877,6 +913,10 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_quotas_template_applications":                     dataSourceAliCloudQuotasTemplateApplications(),
 			"alicloud_cloud_monitor_service_hybrid_double_writes":       dataSourceAliCloudCloudMonitorServiceHybridDoubleWrites(),
 			"alicloud_cms_site_monitors":                                dataSourceAliCloudCloudMonitorServiceSiteMonitors(),
+			"alicloud_resolved_endpoints":                               dataSourceAliCloudResolvedEndpoints(),
+			"alicloud_api_request":                                      dataSourceAliCloudApiRequest(),
+			"alicloud_bss_open_api_prices":                              dataSourceAliCloudBssOpenApiPrices(),
+			"alicloud_cs_cluster_temporary_kubeconfig":                  dataSourceAliCloudCSClusterTemporaryKubeconfig(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"alicloud_oss_access_point":                                     resourceAliCloudOssAccessPoint(),
@@ -1822,8 +1862,19 @@ func Provider() terraform.ResourceProvider {
 			"alicloud_event_bridge_api_destination":                          resourceAliCloudEventBridgeApiDestination(),
 			"alicloud_cloud_monitor_service_monitoring_agent_process":        resourceAliCloudCloudMonitorServiceMonitoringAgentProcess(),
 			"alicloud_cloud_monitor_service_group_monitoring_agent_process":  resourceAliCloudCloudMonitorServiceGroupMonitoringAgentProcess(),
+			"alicloud_api_resource":                                          resourceAliCloudApiResource(),
+			"alicloud_ecs_launch_template_version":                           resourceAliCloudEcsLaunchTemplateVersion(),
 		},
 	}
+	for _, r := range provider.ResourcesMap {
+		withDefaultTags(provider, r)
+		withIgnoreTags(r, false)
+		withRegion(r, false)
+	}
+	for _, r := range provider.DataSourcesMap {
+		withIgnoreTags(r, true)
+		withRegion(r, true)
+	}
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		return providerConfigure(d, provider)
 	}
@@ -1868,10 +1919,16 @@ func providerConfigure(d *schema.ResourceData, p *schema.Provider) (interface{},
 		}
 	}
 
+	credentialProcess := ""
+	if accessKey == "" || secretKey == "" {
+		credentialProcess = getProviderConfig("credential_process", "process_command")
+	}
+
 	config := &connectivity.Config{
 		AccessKey:            strings.TrimSpace(accessKey),
 		SecretKey:            strings.TrimSpace(secretKey),
 		EcsRoleName:          strings.TrimSpace(ecsRoleName),
+		CredentialProcess:    strings.TrimSpace(credentialProcess),
 		Region:               connectivity.Region(strings.TrimSpace(region)),
 		RegionId:             strings.TrimSpace(region),
 		SkipRegionValidation: d.Get("skip_region_validation").(bool),
@@ -1884,6 +1941,12 @@ func providerConfigure(d *schema.ResourceData, p *schema.Provider) (interface{},
 		MaxRetryTimeout:      d.Get("max_retry_timeout").(int),
 		TerraformTraceId:     strings.Trim(uuid.New().String(), "-"),
 		TerraformVersion:     p.TerraformVersion,
+		RecordMode:           strings.TrimSpace(os.Getenv("ALICLOUD_RECORD_MODE")),
+		CassetteFile:         strings.TrimSpace(os.Getenv("ALICLOUD_CASSETTE_FILE")),
+		TraceFile:            strings.TrimSpace(d.Get("trace_file").(string)),
+		EndpointType:         d.Get("endpoint_type").(string),
+		EndpointCacheFile:    strings.TrimSpace(d.Get("endpoint_cache_file").(string)),
+		EndpointCacheTTL:     d.Get("endpoint_cache_ttl").(int),
 	}
 	log.Println("alicloud provider trace id:", config.TerraformTraceId)
 	if accessKey != "" && secretKey != "" {
@@ -1915,8 +1978,8 @@ func providerConfigure(d *schema.ResourceData, p *schema.Provider) (interface{},
 		config.RamRoleSessionExpiration = (int)(expiredSeconds.(float64))
 	}
 
-	assumeRoleList := d.Get("assume_role").(*schema.Set).List()
-	if len(assumeRoleList) == 1 {
+	assumeRoleList := d.Get("assume_role").([]interface{})
+	if len(assumeRoleList) > 0 && assumeRoleList[0] != nil {
 		assumeRole := assumeRoleList[0].(map[string]interface{})
 		if assumeRole["role_arn"].(string) != "" {
 			config.RamRoleArn = assumeRole["role_arn"].(string)
@@ -1946,6 +2009,8 @@ func providerConfigure(d *schema.ResourceData, p *schema.Provider) (interface{},
 
 		log.Printf("[INFO] assume_role configuration set: (RamRoleArn: %q, RamRoleSessionName: %q, RamRolePolicy: %q, RamRoleSessionExpiration: %d, RamRoleExternalId: %s)",
 			config.RamRoleArn, config.RamRoleSessionName, config.RamRolePolicy, config.RamRoleSessionExpiration, config.RamRoleExternalId)
+
+		config.RamRoleChain = getAssumeRoleChain(assumeRoleList[1:])
 	}
 
 	if v, ok := d.GetOk("assume_role_with_oidc"); ok && len(v.([]interface{})) == 1 {
@@ -1957,6 +2022,50 @@ func providerConfigure(d *schema.ResourceData, p *schema.Provider) (interface{},
 			config.AssumeRoleWithOidc.RoleARN, config.AssumeRoleWithOidc.RoleSessionName, config.AssumeRoleWithOidc.DurationSeconds, config.AssumeRoleWithOidc.OIDCProviderArn)
 	}
 
+	if v, ok := d.GetOk("default_tags"); ok && len(v.([]interface{})) == 1 && v.([]interface{})[0] != nil {
+		config.DefaultTags = v.([]interface{})[0].(map[string]interface{})["tags"].(map[string]interface{})
+		log.Printf("[INFO] default_tags configuration set: %v", config.DefaultTags)
+	}
+
+	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]interface{})) == 1 && v.([]interface{})[0] != nil {
+		ignoreTags := v.([]interface{})[0].(map[string]interface{})
+		config.IgnoreTags = &connectivity.IgnoreTags{
+			Keys:        expandStringList(ignoreTags["keys"].(*schema.Set).List()),
+			KeyPrefixes: expandStringList(ignoreTags["key_prefixes"].(*schema.Set).List()),
+		}
+		log.Printf("[INFO] ignore_tags configuration set: (Keys: %v, KeyPrefixes: %v)", config.IgnoreTags.Keys, config.IgnoreTags.KeyPrefixes)
+	}
+
+	if v, ok := d.GetOk("rate_limit"); ok {
+		config.RateLimits = make(map[string]connectivity.RateLimit)
+		for _, rateLimit := range v.(*schema.Set).List() {
+			rateLimitArg := rateLimit.(map[string]interface{})
+			product := strings.ToLower(connectivity.ConvertKebabToSnake(rateLimitArg["product"].(string)))
+			config.RateLimits[product] = connectivity.RateLimit{
+				Rate:  rateLimitArg["rate"].(float64),
+				Burst: rateLimitArg["burst"].(int),
+			}
+		}
+		log.Printf("[INFO] rate_limit configuration set: %v", config.RateLimits)
+	}
+
+	if v, ok := d.GetOk("retry"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		retry := v.([]interface{})[0].(map[string]interface{})
+		config.RetryPolicy = &connectivity.RetryPolicy{
+			MaxAttempts:         retry["max_attempts"].(int),
+			BaseDelay:           time.Duration(retry["base_delay"].(int)) * time.Millisecond,
+			MaxDelay:            time.Duration(retry["max_delay"].(int)) * time.Millisecond,
+			Jitter:              retry["jitter"].(float64),
+			RetryableErrorCodes: make(map[string][]string),
+		}
+		for _, codes := range retry["retryable_error_codes"].(*schema.Set).List() {
+			codesArg := codes.(map[string]interface{})
+			product := strings.ToLower(connectivity.ConvertKebabToSnake(codesArg["product"].(string)))
+			config.RetryPolicy.RetryableErrorCodes[product] = append(config.RetryPolicy.RetryableErrorCodes[product], expandStringList(codesArg["error_codes"].(*schema.Set).List())...)
+		}
+		log.Printf("[INFO] retry configuration set: %+v", *config.RetryPolicy)
+	}
+
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 	var endpointInit sync.Map
 	config.Endpoints = &endpointInit
@@ -2124,7 +2233,7 @@ func providerConfigure(d *schema.ResourceData, p *schema.Provider) (interface{},
 		config.FcEndpoint = strings.TrimSpace(fcEndpoint.(string))
 	}
 	if config.StsEndpoint == "" {
-		config.StsEndpoint = connectivity.LoadRegionalEndpoint(config.RegionId, "sts")
+		config.StsEndpoint = connectivity.LoadRegionalEndpointForType(config.RegionId, "sts", config.EndpointType)
 	}
 
 	configurationSources := []string{
@@ -2220,8 +2329,33 @@ func init() {
 		"source_ip":              "The source ip for the assume role invoking.",
 		"secure_transport":       "The security transport for the assume role invoking.",
 		"credentials_uri":        "The URI of sidecar credentials service.",
+		"credential_process":     "The command to get the credentials, which prints the json with the AccessKeyId, AccessKeySecret, SecurityToken and Expiration. It is run again before the credentials expire.",
 		"max_retry_timeout":      "The maximum retry timeout of the request.",
 
+		"default_tags_tags": "The tags applied to all resources that support tags. The tags set on a resource take precedence over the default tags with the same key.",
+
+		"ignore_tags_keys":         "The tag keys which are managed outside of Terraform and ignored when reading the tags.",
+		"ignore_tags_key_prefixes": "The tag key prefixes which are managed outside of Terraform and ignored when reading the tags.",
+
+		"rate_limit_product": "The code of the product to limit the requests, like `ecs` and `vpc`.",
+		"rate_limit_rate":    "The maximum number of the requests per second sent to the product.",
+		"rate_limit_burst":   "The maximum number of the requests sent to the product at once.",
+
+		"retry_max_attempts":          "The maximum number of the attempts of a request, including the first one.",
+		"retry_base_delay":            "The delay in milliseconds before the first retry. It doubles for every later retry.",
+		"retry_max_delay":             "The maximum delay in milliseconds before a retry.",
+		"retry_jitter":                "The randomized fraction of the delay before a retry, from 0 to 1.",
+		"retry_retryable_error_codes": "The extra error codes of a product to retry, besides the throttling errors and the 5xx errors.",
+		"retry_product":               "The code of the product, like `ecs` and `vpc`.",
+		"retry_error_codes":           "The error codes to retry, like `IncorrectInstanceStatus`.",
+
+		"trace_file": "The file to write the traced api calls into, one json line per call. The secret fields of the requests are redacted.",
+
+		"endpoint_type": "The type of the endpoints to reach the products, one of `public`, `vpc` and `intranet`. The endpoints set by the `endpoints` block, the environment variables and the endpoints.xml take precedence over it.",
+
+		"endpoint_cache_file": "The file to cache the endpoints resolved by the location service, which are reused by all of the provider aliases and the later runs.",
+		"endpoint_cache_ttl":  "The time to live of the cached endpoints in seconds.",
+
 		"ecs_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom ECS endpoints.",
 
 		"rds_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom RDS endpoints.",
@@ -2485,11 +2619,99 @@ func init() {
 	}
 }
 
-func assumeRoleSchema() *schema.Schema {
+func rateLimitSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
 		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"product": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: descriptions["rate_limit_product"],
+				},
+				"rate": {
+					Type:         schema.TypeFloat,
+					Required:     true,
+					Description:  descriptions["rate_limit_rate"],
+					ValidateFunc: validation.FloatAtLeast(0.01),
+				},
+				"burst": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1,
+					Description:  descriptions["rate_limit_burst"],
+					ValidateFunc: IntAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func retrySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
 		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"max_attempts": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      3,
+					Description:  descriptions["retry_max_attempts"],
+					ValidateFunc: IntAtLeast(1),
+				},
+				"base_delay": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      1000,
+					Description:  descriptions["retry_base_delay"],
+					ValidateFunc: IntAtLeast(0),
+				},
+				"max_delay": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Default:      10000,
+					Description:  descriptions["retry_max_delay"],
+					ValidateFunc: IntAtLeast(0),
+				},
+				"jitter": {
+					Type:         schema.TypeFloat,
+					Optional:     true,
+					Default:      0.5,
+					Description:  descriptions["retry_jitter"],
+					ValidateFunc: validation.FloatBetween(0, 1),
+				},
+				"retryable_error_codes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: descriptions["retry_retryable_error_codes"],
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"product": {
+								Type:        schema.TypeString,
+								Required:    true,
+								Description: descriptions["retry_product"],
+							},
+							"error_codes": {
+								Type:        schema.TypeSet,
+								Required:    true,
+								Description: descriptions["retry_error_codes"],
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func assumeRoleSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"role_arn": {
@@ -3822,7 +4044,7 @@ func getConfigFromProfile(d *schema.ResourceData, ProfileKey string) (interface{
 	}
 	switch ProfileKey {
 	case "access_key_id", "access_key_secret":
-		if mode == "EcsRamRole" {
+		if mode == "EcsRamRole" || mode == "External" {
 			return "", nil
 		}
 	case "ram_role_name":
@@ -3837,6 +4059,10 @@ func getConfigFromProfile(d *schema.ResourceData, ProfileKey string) (interface{
 		if mode != "RamRoleArn" {
 			return "", nil
 		}
+	case "process_command":
+		if mode != "External" {
+			return "", nil
+		}
 	case "expired_seconds":
 		if mode != "RamRoleArn" {
 			return float64(0), nil
@@ -3846,6 +4072,34 @@ func getConfigFromProfile(d *schema.ResourceData, ProfileKey string) (interface{
 	return providerConfig[ProfileKey], nil
 }
 
+// getAssumeRoleChain returns the assume_role blocks after the first one, which are assumed in order.
+func getAssumeRoleChain(assumeRoleList []interface{}) []connectivity.AssumeRole {
+	chain := make([]connectivity.AssumeRole, 0, len(assumeRoleList))
+	for _, raw := range assumeRoleList {
+		if raw == nil {
+			continue
+		}
+		assumeRole := raw.(map[string]interface{})
+		hop := connectivity.AssumeRole{
+			RoleArn:           assumeRole["role_arn"].(string),
+			SessionName:       assumeRole["session_name"].(string),
+			Policy:            assumeRole["policy"].(string),
+			ExternalId:        assumeRole["external_id"].(string),
+			SessionExpiration: assumeRole["session_expiration"].(int),
+		}
+		if hop.SessionName == "" {
+			hop.SessionName = "terraform"
+		}
+		if hop.SessionExpiration == 0 {
+			hop.SessionExpiration = 3600
+		}
+		log.Printf("[INFO] chained assume_role configuration set: (RoleArn: %q, SessionName: %q, Policy: %q, SessionExpiration: %d, ExternalId: %s)",
+			hop.RoleArn, hop.SessionName, hop.Policy, hop.SessionExpiration, hop.ExternalId)
+		chain = append(chain, hop)
+	}
+	return chain
+}
+
 func getAssumeRoleWithOIDCConfig(tfMap map[string]interface{}) (*connectivity.AssumeRoleWithOidc, error) {
 	if tfMap == nil {
 		return nil, nil
@@ -0,0 +1,34 @@
+package alicloud
+
+import (
+	"context"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/framework"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+)
+
+// ProtoV6ProviderServerFactory returns the factory of the protocol v6 server which muxes the SDK provider
+// and the framework provider in the package framework, so both of their resources, data sources and
+// functions are served by the same binary.
+func ProtoV6ProviderServerFactory(ctx context.Context) (func() tfprotov6.ProviderServer, error) {
+	sdkProvider := Provider()
+	sdkServer, err := tf5to6server.UpgradeServer(ctx, sdkProvider.GRPCProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	// The servers are configured one by one in the order. The SDK provider server must be the first one,
+	// because the framework provider uses the client configured by it.
+	muxServer, err := tf6muxserver.NewMuxServer(ctx,
+		func() tfprotov6.ProviderServer {
+			return sdkServer
+		},
+		framework.NewProtocol6Server(sdkProvider.Meta),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return muxServer.ProviderServer, nil
+}
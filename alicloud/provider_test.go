@@ -2,12 +2,14 @@ package alicloud
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -20,39 +22,90 @@ import (
 	"github.com/aliyun/fc-go-sdk"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 
 	"strings"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
-var testAccProviders map[string]terraform.ResourceProvider
+var testAccProviders map[string]*schema.Provider
 var testAccProvider *schema.Provider
 var defaultRegionToTest = os.Getenv("ALICLOUD_REGION")
 
 func init() {
-	testAccProvider = Provider().(*schema.Provider)
-	testAccProviders = map[string]terraform.ResourceProvider{
+	testAccProvider = Provider()
+	testAccProviders = map[string]*schema.Provider{
 		"alicloud": testAccProvider,
 	}
 	setStsCredential()
 }
 
 func TestProvider(t *testing.T) {
-	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
+	if err := Provider().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 }
 
 func TestProvider_impl(t *testing.T) {
-	var _ terraform.ResourceProvider = Provider()
+	var _ *schema.Provider = Provider()
+}
+
+func TestUnitAliCloudProviderServer(t *testing.T) {
+	ctx := context.Background()
+	serverFactory, err := ProtoV6ProviderServerFactory(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := serverFactory().GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, diag := range resp.Diagnostics {
+		if diag.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("unexpected error %s: %s", diag.Summary, diag.Detail)
+		}
+	}
+	if resp.Provider == nil || len(resp.Provider.Block.Attributes) == 0 {
+		t.Fatal("the provider schema of the SDK provider should be served.")
+	}
+	if len(resp.ResourceSchemas) != len(Provider().ResourcesMap) || len(resp.DataSourceSchemas) != len(Provider().DataSourcesMap) {
+		t.Fatalf("all of the SDK resources and data sources should be served, got %d resources and %d data sources.", len(resp.ResourceSchemas), len(resp.DataSourceSchemas))
+	}
+}
+
+func TestUnitAliCloudProviderAssumeRoleChain(t *testing.T) {
+	hop := func(roleArn, sessionName string, sessionExpiration int) map[string]interface{} {
+		return map[string]interface{}{
+			"role_arn":           roleArn,
+			"session_name":       sessionName,
+			"policy":             "",
+			"external_id":        "",
+			"session_expiration": sessionExpiration,
+		}
+	}
+	chain := getAssumeRoleChain([]interface{}{
+		hop("acs:ram::1:role/security", "", 0),
+		nil,
+		hop("acs:ram::2:role/workload", "tf-workload", 900),
+	})
+	if len(chain) != 2 {
+		t.Fatalf("expected 2 hops, got %d.", len(chain))
+	}
+	if chain[0].RoleArn != "acs:ram::1:role/security" || chain[0].SessionName != "terraform" || chain[0].SessionExpiration != 3600 {
+		t.Fatalf("the first hop should use the default session name and expiration, got %#v.", chain[0])
+	}
+	if chain[1].RoleArn != "acs:ram::2:role/workload" || chain[1].SessionName != "tf-workload" || chain[1].SessionExpiration != 900 {
+		t.Fatalf("the hops should be kept in order, got %#v.", chain[1])
+	}
 }
 
 func testAccPreCheck(t *testing.T) {
+	testAccPreCheckWithCassette(t)
 	if v := os.Getenv("ALICLOUD_ACCESS_KEY"); v == "" {
 		t.Fatal("ALICLOUD_ACCESS_KEY must be set for acceptance tests")
 	}
@@ -67,6 +120,27 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// testAccPreCheckWithCassette sets the cassette file of the test when the requests are recorded or replayed.
+// The cassette files are named after the tests in the ALICLOUD_CASSETTE_DIR. The replayed requests do not need
+// the real credentials, so the placeholder credentials are used if they are not set.
+func testAccPreCheckWithCassette(t *testing.T) {
+	mode := os.Getenv("ALICLOUD_RECORD_MODE")
+	if mode == "" {
+		return
+	}
+	if dir := os.Getenv("ALICLOUD_CASSETTE_DIR"); dir != "" {
+		os.Setenv("ALICLOUD_CASSETTE_FILE", filepath.Join(dir, t.Name()+".json"))
+	}
+	if mode == connectivity.RecordModeReplay {
+		if os.Getenv("ALICLOUD_ACCESS_KEY") == "" {
+			os.Setenv("ALICLOUD_ACCESS_KEY", "replay-access-key")
+		}
+		if os.Getenv("ALICLOUD_SECRET_KEY") == "" {
+			os.Setenv("ALICLOUD_SECRET_KEY", "replay-secret-key")
+		}
+	}
+}
+
 func testAccPreCheckForCleanUpInstances(t *testing.T, instanceRegion, productCode, productType, productCodeIntl, productTypeIntl string) {
 	rawClient, err := sharedClientForRegion(defaultRegionToTest)
 	if err != nil {
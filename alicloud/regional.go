@@ -0,0 +1,121 @@
+package alicloud
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// withRegion adds the region argument to the resource, which sends the requests of the resource to the region instead
+// of the region of the provider. The functions of the resource are called with the client of the region, so the
+// same configuration can be applied to many regions without the provider aliases.
+func withRegion(r *schema.Resource, isDataSource bool) {
+	if _, ok := r.Schema["region"]; ok || r.Read == nil {
+		return
+	}
+	r.Schema["region"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Computed: true,
+		ForceNew: !isDataSource,
+	}
+
+	read := r.Read
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		client, err := regionalClient(d.Get("region").(string), meta)
+		if err != nil {
+			return WrapError(err)
+		}
+		if err := read(d, client); err != nil {
+			return err
+		}
+		return setRegion(d, client)
+	}
+	if isDataSource {
+		return
+	}
+	if create := r.Create; create != nil {
+		r.Create = func(d *schema.ResourceData, meta interface{}) error {
+			client, err := regionalClient(d.Get("region").(string), meta)
+			if err != nil {
+				return WrapError(err)
+			}
+			if err := create(d, client); err != nil {
+				return err
+			}
+			return setRegion(d, client)
+		}
+	}
+	if update := r.Update; update != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			client, err := regionalClient(d.Get("region").(string), meta)
+			if err != nil {
+				return WrapError(err)
+			}
+			return update(d, client)
+		}
+	}
+	if del := r.Delete; del != nil {
+		r.Delete = func(d *schema.ResourceData, meta interface{}) error {
+			client, err := regionalClient(d.Get("region").(string), meta)
+			if err != nil {
+				return WrapError(err)
+			}
+			return del(d, client)
+		}
+	}
+	if customizeDiff := r.CustomizeDiff; customizeDiff != nil {
+		r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+			client, err := regionalClient(diff.Get("region").(string), meta)
+			if err != nil {
+				return WrapError(err)
+			}
+			return customizeDiff(ctx, diff, client)
+		}
+	}
+	if r.Importer != nil && r.Importer.State != nil {
+		state := r.Importer.State
+		r.Importer.State = func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			// The resource of another region is imported with the id like <id>@<region>.
+			if i := strings.LastIndex(d.Id(), "@"); i > 0 && isValidRegion(d.Id()[i+1:]) {
+				if err := d.Set("region", d.Id()[i+1:]); err != nil {
+					return nil, WrapError(err)
+				}
+				d.SetId(d.Id()[:i])
+			}
+			client, err := regionalClient(d.Get("region").(string), meta)
+			if err != nil {
+				return nil, WrapError(err)
+			}
+			return state(d, client)
+		}
+	}
+}
+
+// regionalClient returns the client of the region, or the meta itself if the region is not set.
+func regionalClient(region string, meta interface{}) (interface{}, error) {
+	client, ok := meta.(*connectivity.AliyunClient)
+	if !ok || client == nil || region == "" {
+		return meta, nil
+	}
+	return client.WithRegion(region)
+}
+
+func setRegion(d *schema.ResourceData, meta interface{}) error {
+	client, ok := meta.(*connectivity.AliyunClient)
+	if !ok || client == nil || d.Id() == "" {
+		return nil
+	}
+	return d.Set("region", client.RegionId)
+}
+
+func isValidRegion(region string) bool {
+	for _, valid := range connectivity.ValidRegions {
+		if string(valid) == region {
+			return true
+		}
+	}
+	return false
+}
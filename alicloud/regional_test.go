@@ -0,0 +1,56 @@
+package alicloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestWithRegion(t *testing.T) {
+	p := Provider()
+	if v, ok := p.ResourcesMap["alicloud_vpc"].Schema["region"]; !ok || !v.Optional || !v.Computed || !v.ForceNew {
+		t.Fatal("resource alicloud_vpc should have the optional and computed region forcing a new resource.")
+	}
+	if v, ok := p.DataSourcesMap["alicloud_vpcs"].Schema["region"]; !ok || !v.Optional || v.ForceNew {
+		t.Fatal("data source alicloud_vpcs should have the optional region.")
+	}
+
+	var readRegion string
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+		Create: func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			readRegion = d.Get("region").(string)
+			return nil
+		},
+		Delete:   func(d *schema.ResourceData, meta interface{}) error { return nil },
+		Importer: &schema.ResourceImporter{State: schema.ImportStatePassthrough},
+	}
+	withRegion(r, false)
+
+	d := r.TestResourceData()
+	d.SetId("vpc-123@cn-beijing")
+	states, err := r.Importer.State(d, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if states[0].Id() != "vpc-123" || states[0].Get("region") != "cn-beijing" {
+		t.Errorf("importing the id with the region got the id %s and the region %s.", states[0].Id(), states[0].Get("region"))
+	}
+	if err := r.Read(states[0], nil); err != nil || readRegion != "cn-beijing" {
+		t.Errorf("reading the imported resource got the region %s, %v.", readRegion, err)
+	}
+
+	d = r.TestResourceData()
+	d.SetId("user@example.com")
+	states, _ = r.Importer.State(d, nil)
+	if states[0].Id() != "user@example.com" || states[0].Get("region") != "" {
+		t.Errorf("the id without a region should not be changed, got %s and %s.", states[0].Id(), states[0].Get("region"))
+	}
+}
@@ -7,9 +7,9 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlicloudActiontrailTrail() *schema.Resource {
@@ -275,13 +275,6 @@ func resourceAlicloudActiontrailTrailUpdate(d *schema.ResourceData, meta interfa
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("event_rw")
-		d.SetPartial("oss_bucket_name")
-		d.SetPartial("oss_key_prefix")
-		d.SetPartial("oss_write_role_arn")
-		d.SetPartial("sls_project_arn")
-		d.SetPartial("sls_write_role_arn")
-		d.SetPartial("trail_region")
 	}
 	if d.HasChange("status") {
 		object, err := actiontrailService.DescribeActiontrailTrail(d.Id())
@@ -342,7 +335,6 @@ func resourceAlicloudActiontrailTrailUpdate(d *schema.ResourceData, meta interfa
 					return WrapErrorf(err, IdMsg, d.Id())
 				}
 			}
-			d.SetPartial("status")
 		}
 	}
 	d.Partial(false)
@@ -8,9 +8,9 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/adb"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlicloudAdbAccount() *schema.Resource {
@@ -63,7 +63,7 @@ func resourceAlicloudAdbAccount() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{string("Super")}, false),
 				Default:      "Super",
 				ForceNew:     true,
-				Removed:      "Field 'account_type' has been removed from provider version 1.81.0.",
+				Deprecated:   "Field 'account_type' has been removed from provider version 1.81.0.",
 			},
 
 			"account_description": {
@@ -182,7 +182,6 @@ func resourceAlicloudAdbAccountUpdate(d *schema.ResourceData, meta interface{})
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-		d.SetPartial("account_description")
 	}
 
 	if d.HasChange("account_password") || d.HasChange("kms_encrypted_password") {
@@ -218,7 +217,6 @@ func resourceAlicloudAdbAccountUpdate(d *schema.ResourceData, meta interface{})
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-		d.SetPartial("account_password")
 	}
 
 	d.Partial(false)
@@ -8,8 +8,8 @@ import (
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudAdbDbClusterLakeVersion() *schema.Resource {
@@ -315,8 +315,6 @@ func resourceAliCloudAdbDbClusterLakeVersionUpdate(d *schema.ResourceData, meta
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("compute_resource")
-		d.SetPartial("storage_resource")
 	}
 
 	update = false
@@ -356,7 +354,6 @@ func resourceAliCloudAdbDbClusterLakeVersionUpdate(d *schema.ResourceData, meta
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("security_ips")
 	}
 
 	update = false
@@ -396,7 +393,6 @@ func resourceAliCloudAdbDbClusterLakeVersionUpdate(d *schema.ResourceData, meta
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("db_cluster_description")
 	}
 
 	update = false
@@ -436,7 +432,6 @@ func resourceAliCloudAdbDbClusterLakeVersionUpdate(d *schema.ResourceData, meta
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("resource_group_id")
 	}
 
 	d.Partial(false)
@@ -10,8 +10,8 @@ import (
 
 	"github.com/PaesslerAG/jsonpath"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudAdbLakeAccount() *schema.Resource {
@@ -245,7 +245,6 @@ func resourceAliCloudAdbLakeAccountUpdate(d *schema.ResourceData, meta interface
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("account_description")
 	}
 	update = false
 	parts = strings.Split(d.Id(), ":")
@@ -281,8 +280,6 @@ func resourceAliCloudAdbLakeAccountUpdate(d *schema.ResourceData, meta interface
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("account_password")
-		d.SetPartial("account_description")
 	}
 	update = false
 	parts = strings.Split(d.Id(), ":")
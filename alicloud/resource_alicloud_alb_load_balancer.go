@@ -9,8 +9,8 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudAlbLoadBalancer() *schema.Resource {
@@ -508,7 +508,6 @@ func resourceAliCloudAlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("load_balancer_edition")
 	}
 
 	update = false
@@ -558,7 +557,6 @@ func resourceAliCloudAlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("address_type")
 	}
 
 	update = false
@@ -606,7 +604,6 @@ func resourceAliCloudAlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("resource_group_id")
 	}
 
 	update = false
@@ -678,8 +675,6 @@ func resourceAliCloudAlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("load_balancer_name")
-		d.SetPartial("modification_protection_config")
 	}
 
 	update = false
@@ -741,7 +736,6 @@ func resourceAliCloudAlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("zone_mappings")
 	}
 
 	if d.HasChange("ipv6_address_type") {
@@ -836,7 +830,6 @@ func resourceAliCloudAlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 				}
 			}
 
-			d.SetPartial("ipv6_address_type")
 		}
 	}
 
@@ -942,7 +935,6 @@ func resourceAliCloudAlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 				}
 			}
 
-			d.SetPartial("deletion_protection_enabled")
 		}
 	}
 
@@ -1044,7 +1036,6 @@ func resourceAliCloudAlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 			}
 		}
 
-		d.SetPartial("access_log_config")
 	}
 
 	if !d.IsNewResource() && d.HasChange("tags") {
@@ -1052,7 +1043,6 @@ func resourceAliCloudAlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 			return WrapError(err)
 		}
 
-		d.SetPartial("tags")
 	}
 
 	d.Partial(false)
@@ -10,13 +10,13 @@ import (
 	"github.com/agiledragon/gomonkey/v2"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/alibabacloud-go/tea-rpc/client"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestAccAlicloudAlidnsAccessStrategy_basic0(t *testing.T) {
@@ -586,7 +586,7 @@ resource "alicloud_alidns_address_pool" "ipv6" {
 }
 
 func TestUnitAlicloudAlidnsAccessStrategy(t *testing.T) {
-	p := Provider().(*schema.Provider).ResourcesMap
+	p := Provider().ResourcesMap
 	dInit, _ := schema.InternalMap(p["alicloud_alidns_access_strategy"].Schema).Data(nil, nil)
 	dExisted, _ := schema.InternalMap(p["alicloud_alidns_access_strategy"].Schema).Data(nil, nil)
 	dInit.MarkNewResource()
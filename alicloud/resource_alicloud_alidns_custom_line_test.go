@@ -10,14 +10,14 @@ import (
 	"github.com/agiledragon/gomonkey/v2"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/alibabacloud-go/tea-rpc/client"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestAccAlicloudAlidnsCustomLine_basic0(t *testing.T) {
@@ -132,7 +132,7 @@ variable "domain_name" {
 }
 
 func TestUnitAlicloudAlidnsCustomLine(t *testing.T) {
-	p := Provider().(*schema.Provider).ResourcesMap
+	p := Provider().ResourcesMap
 	d, _ := schema.InternalMap(p["alicloud_alidns_custom_line"].Schema).Data(nil, nil)
 	dCreate, _ := schema.InternalMap(p["alicloud_alidns_custom_line"].Schema).Data(nil, nil)
 	dCreate.MarkNewResource()
@@ -280,14 +280,14 @@ func TestUnitAlicloudAlidnsCustomLine(t *testing.T) {
 		for _, key := range []string{"custom_line_name", "lang", "ip_segment_list"} {
 			switch p["alicloud_alidns_custom_line"].Schema[key].Type {
 			case schema.TypeString:
-				diff.SetAttribute(key, &terraform.ResourceAttrDiff{Old: d.Get(key).(string), New: d.Get(key).(string) + "_update"})
+				diff.Attributes[key] = &terraform.ResourceAttrDiff{Old: d.Get(key).(string), New: d.Get(key).(string) + "_update"}
 			case schema.TypeBool:
-				diff.SetAttribute(key, &terraform.ResourceAttrDiff{Old: strconv.FormatBool(d.Get(key).(bool)), New: strconv.FormatBool(true)})
+				diff.Attributes[key] = &terraform.ResourceAttrDiff{Old: strconv.FormatBool(d.Get(key).(bool)), New: strconv.FormatBool(true)}
 			case schema.TypeInt:
-				diff.SetAttribute(key, &terraform.ResourceAttrDiff{Old: strconv.Itoa(d.Get(key).(int)), New: strconv.Itoa(3)})
+				diff.Attributes[key] = &terraform.ResourceAttrDiff{Old: strconv.Itoa(d.Get(key).(int)), New: strconv.Itoa(3)}
 			case schema.TypeList:
-				diff.SetAttribute("ip_segment_list.0.end_ip", &terraform.ResourceAttrDiff{Old: "", New: "192.0.2.123"})
-				diff.SetAttribute("ip_segment_list.0.start_ip", &terraform.ResourceAttrDiff{Old: "", New: "192.0.2.125"})
+				diff.Attributes["ip_segment_list.0.end_ip"] = &terraform.ResourceAttrDiff{Old: "", New: "192.0.2.123"}
+				diff.Attributes["ip_segment_list.0.start_ip"] = &terraform.ResourceAttrDiff{Old: "", New: "192.0.2.125"}
 			}
 		}
 		resourceData1, _ := schema.InternalMap(p["alicloud_alidns_custom_line"].Schema).Data(nil, diff)
@@ -314,14 +314,14 @@ func TestUnitAlicloudAlidnsCustomLine(t *testing.T) {
 		for _, key := range []string{"custom_line_name", "lang", "ip_segment_list"} {
 			switch p["alicloud_alidns_custom_line"].Schema[key].Type {
 			case schema.TypeString:
-				diff.SetAttribute(key, &terraform.ResourceAttrDiff{Old: d.Get(key).(string), New: d.Get(key).(string) + "_update"})
+				diff.Attributes[key] = &terraform.ResourceAttrDiff{Old: d.Get(key).(string), New: d.Get(key).(string) + "_update"}
 			case schema.TypeBool:
-				diff.SetAttribute(key, &terraform.ResourceAttrDiff{Old: strconv.FormatBool(d.Get(key).(bool)), New: strconv.FormatBool(true)})
+				diff.Attributes[key] = &terraform.ResourceAttrDiff{Old: strconv.FormatBool(d.Get(key).(bool)), New: strconv.FormatBool(true)}
 			case schema.TypeInt:
-				diff.SetAttribute(key, &terraform.ResourceAttrDiff{Old: strconv.Itoa(d.Get(key).(int)), New: strconv.Itoa(3)})
+				diff.Attributes[key] = &terraform.ResourceAttrDiff{Old: strconv.Itoa(d.Get(key).(int)), New: strconv.Itoa(3)}
 			case schema.TypeSet:
-				diff.SetAttribute("ip_segment_list.0.end_ip", &terraform.ResourceAttrDiff{Old: "", New: "192.0.2.123"})
-				diff.SetAttribute("ip_segment_list.0.start_ip", &terraform.ResourceAttrDiff{Old: "", New: "192.0.2.125"})
+				diff.Attributes["ip_segment_list.0.end_ip"] = &terraform.ResourceAttrDiff{Old: "", New: "192.0.2.123"}
+				diff.Attributes["ip_segment_list.0.start_ip"] = &terraform.ResourceAttrDiff{Old: "", New: "192.0.2.125"}
 			}
 		}
 		resourceData1, _ := schema.InternalMap(p["alicloud_alidns_custom_line"].Schema).Data(nil, diff)
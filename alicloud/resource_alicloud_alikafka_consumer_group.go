@@ -8,8 +8,8 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudAlikafkaConsumerGroup() *schema.Resource {
@@ -117,7 +117,6 @@ func resourceAlicloudAlikafkaConsumerGroupUpdate(d *schema.ResourceData, meta in
 		if err := alikafkaService.SetResourceTags(d, "CONSUMERGROUP"); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 	return resourceAlicloudAlikafkaConsumerGroupRead(d, meta)
 }
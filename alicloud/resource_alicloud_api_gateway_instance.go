@@ -10,8 +10,8 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudApiGatewayInstance() *schema.Resource {
@@ -141,26 +141,7 @@ func resourceAliCloudApiGatewayInstance() *schema.Resource {
 				Type:          schema.TypeMap,
 				Optional:      true,
 				ConflictsWith: []string{"delete_vpc_ip_block"},
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"vswitch_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"zone_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"cidr_block": {
-							Type:     schema.TypeString,
-							Required: true,
-						},
-						"customized": {
-							Type:     schema.TypeBool,
-							Optional: true,
-						},
-					},
-				},
+				Elem:          &schema.Schema{Type: schema.TypeString},
 			},
 			"delete_vpc_ip_block": {
 				Type:          schema.TypeString,
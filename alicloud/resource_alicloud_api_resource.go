@@ -0,0 +1,252 @@
+package alicloud
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/PaesslerAG/jsonpath"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAliCloudApiResource() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliCloudApiResourceCreate,
+		Read:   resourceAliCloudApiResourceRead,
+		Update: resourceAliCloudApiResourceUpdate,
+		Delete: resourceAliCloudApiResourceDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		CustomizeDiff: resourceAliCloudApiResourceCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"product": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"style": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      ApiStyleRpc,
+				ValidateFunc: StringInSlice([]string{ApiStyleRpc, ApiStyleRoa}, false),
+			},
+			"create": apiActionSchema(true),
+			"read":   apiActionSchema(true),
+			"update": apiActionSchema(false),
+			"delete": apiActionSchema(false),
+			"id_path": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"pending_statuses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"target_statuses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"not_found_error_codes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"result_paths": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"results": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"response": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceAliCloudApiResourceCustomizeDiff replaces the resource when the create action changes and there is
+// no update action to apply the change.
+func resourceAliCloudApiResourceCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("create") {
+		return nil
+	}
+	if v, ok := diff.GetOk("update"); ok && len(v.([]interface{})) > 0 {
+		return nil
+	}
+	return diff.ForceNew("create")
+}
+
+func resourceAliCloudApiResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	apiService := ApiService{client}
+
+	action, _ := apiService.expandApiAction(d, "create", "")
+	response, err := apiService.doApiActionWithRetry(action, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alicloud_api_resource", action.Action, AlibabaCloudSdkGoERROR)
+	}
+	id, err := jsonpath.Get(d.Get("id_path").(string), response)
+	if err != nil || apiResultString(id) == "" {
+		return WrapErrorf(fmt.Errorf("the id is not found"), FailedGetAttributeMsg, "alicloud_api_resource", d.Get("id_path"), response)
+	}
+	d.SetId(apiResultString(id))
+
+	if err := apiService.waitForApiResourceStatus(d, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	return resourceAliCloudApiResourceRead(d, meta)
+}
+
+func resourceAliCloudApiResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	apiService := ApiService{client}
+
+	response, err := apiService.DescribeApiResource(d)
+	if err != nil {
+		if !d.IsNewResource() && NotFoundError(err) {
+			log.Printf("[DEBUG] Resource alicloud_api_resource DescribeApiResource Failed!!! %s", err)
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	results, err := apiResults(response, d.Get("result_paths").(map[string]interface{}))
+	if err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("results", results); err != nil {
+		return WrapError(err)
+	}
+	d.Set("response", convertObjectToJsonString(response))
+
+	return nil
+}
+
+func resourceAliCloudApiResourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	apiService := ApiService{client}
+
+	if d.HasChanges("create", "update") {
+		if action, ok := apiService.expandApiAction(d, "update", d.Id()); ok {
+			if _, err := apiService.doApiActionWithRetry(action, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action.Action, AlibabaCloudSdkGoERROR)
+			}
+			if err := apiService.waitForApiResourceStatus(d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return WrapErrorf(err, IdMsg, d.Id())
+			}
+		}
+	}
+
+	return resourceAliCloudApiResourceRead(d, meta)
+}
+
+func resourceAliCloudApiResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	apiService := ApiService{client}
+
+	action, ok := apiService.expandApiAction(d, "delete", d.Id())
+	if !ok {
+		log.Printf("[WARN] Cannot destroy resource alicloud_api_resource without the delete action. Terraform will remove this resource from the state file, however resources may remain.")
+		return nil
+	}
+	if _, err := apiService.doApiActionWithRetry(action, d.Timeout(schema.TimeoutDelete)); err != nil {
+		if apiService.isApiResourceNotFound(d, err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action.Action, AlibabaCloudSdkGoERROR)
+	}
+
+	return nil
+}
+
+// DescribeApiResource sends the read action of the resource. The errors with the not found error codes are
+// returned as the not found error.
+func (s *ApiService) DescribeApiResource(d *schema.ResourceData) (map[string]interface{}, error) {
+	action, _ := s.expandApiAction(d, "read", d.Id())
+	response, err := s.doApiActionWithRetry(action, 5*time.Minute)
+	if err != nil {
+		if s.isApiResourceNotFound(d, err) {
+			return nil, WrapErrorf(err, NotFoundMsg, AlibabaCloudSdkGoERROR)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, d.Id(), action.Action, AlibabaCloudSdkGoERROR)
+	}
+	return response, nil
+}
+
+func (s *ApiService) isApiResourceNotFound(d *schema.ResourceData, err error) bool {
+	return NotFoundError(err) || IsExpectedErrors(err, expandStringList(d.Get("not_found_error_codes").([]interface{})))
+}
+
+func (s *ApiService) doApiActionWithRetry(action *ApiAction, timeout time.Duration) (response map[string]interface{}, err error) {
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		response, err = s.DoApiAction(action)
+		if err != nil {
+			if NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	return response, err
+}
+
+// waitForApiResourceStatus polls the read action until the value of the status path is one of the target statuses.
+func (s *ApiService) waitForApiResourceStatus(d *schema.ResourceData, timeout time.Duration) error {
+	statusPath := d.Get("status_path").(string)
+	targets := expandStringList(d.Get("target_statuses").([]interface{}))
+	if statusPath == "" || len(targets) == 0 {
+		return nil
+	}
+	stateConf := BuildStateConf(expandStringList(d.Get("pending_statuses").([]interface{})), targets, timeout, 5*time.Second, s.ApiResourceStateRefreshFunc(d, statusPath))
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func (s *ApiService) ApiResourceStateRefreshFunc(d *schema.ResourceData, statusPath string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		response, err := s.DescribeApiResource(d)
+		if err != nil {
+			if NotFoundError(err) {
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+		status, err := jsonpath.Get(statusPath, response)
+		if err != nil {
+			return nil, "", WrapErrorf(err, FailedGetAttributeMsg, d.Id(), statusPath, response)
+		}
+		return response, apiResultString(status), nil
+	}
+}
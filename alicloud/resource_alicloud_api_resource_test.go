@@ -0,0 +1,109 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccAliCloudApiResource_basic(t *testing.T) {
+	resourceId := "alicloud_api_resource.default"
+	name := fmt.Sprintf("tf-testacc-api-resource-%d", acctest.RandIntRange(10000, 99999))
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliCloudApiResourceConfig(name, "created"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceId, "id"),
+					resource.TestCheckResourceAttr(resourceId, "results.name", name),
+					resource.TestCheckResourceAttr(resourceId, "results.description", "created"),
+					resource.TestCheckResourceAttr(resourceId, "results.status", "Available"),
+				),
+			},
+			{
+				Config: testAccAliCloudApiResourceConfig(name, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceId, "results.description", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAliCloudApiResourceConfig(name, description string) string {
+	return fmt.Sprintf(`
+resource "alicloud_api_resource" "default" {
+  product = "vpc"
+  version = "2016-04-28"
+  create {
+    action = "CreateVpc"
+    query = {
+      VpcName     = "%[1]s"
+      CidrBlock   = "172.16.0.0/12"
+      Description = "%[2]s"
+    }
+  }
+  read {
+    action = "DescribeVpcAttribute"
+    query = {
+      VpcId = "$${id}"
+    }
+  }
+  update {
+    action = "ModifyVpcAttribute"
+    query = {
+      VpcId       = "$${id}"
+      Description = "%[2]s"
+    }
+  }
+  delete {
+    action = "DeleteVpc"
+    query = {
+      VpcId = "$${id}"
+    }
+  }
+  id_path               = "$.VpcId"
+  status_path           = "$.Status"
+  pending_statuses      = ["Pending"]
+  target_statuses       = ["Available"]
+  not_found_error_codes = ["InvalidVpcId.NotFound"]
+  result_paths = {
+    name        = "$.VpcName"
+    description = "$.Description"
+    status      = "$.Status"
+  }
+}
+`, name, description)
+}
+
+func TestUnitAliCloudApiResourceResults(t *testing.T) {
+	response := map[string]interface{}{
+		"VpcId":  "vpc-abc",
+		"Status": "Available",
+		"Count":  float64(1000000),
+		"Tags": map[string]interface{}{
+			"Tag": []interface{}{map[string]interface{}{"Key": "k1", "Value": "v1"}},
+		},
+	}
+	results, err := apiResults(response, map[string]interface{}{
+		"id":    "$.VpcId",
+		"count": "$.Count",
+		"keys":  "$.Tags.Tag[*].Key",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"id": "vpc-abc", "count": "1000000", "keys": `["k1"]`}, results)
+
+	_, err = apiResults(response, map[string]interface{}{"missing": "$.Missing"})
+	assert.NotNil(t, err)
+
+	assert.True(t, apiBodyDiffSuppressFunc("body", `{"a": 1, "b": [1, 2]}`, `{"b":[1,2],"a":1}`, nil))
+	assert.False(t, apiBodyDiffSuppressFunc("body", `{"a": 1}`, `{"a": 2}`, nil))
+}
@@ -10,8 +10,8 @@ import (
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudArmsPrometheusMonitoring() *schema.Resource {
@@ -177,7 +177,6 @@ func resourceAliCloudArmsPrometheusMonitoringUpdate(d *schema.ResourceData, meta
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("config_yaml")
 	}
 	update = false
 	parts = strings.Split(d.Id(), ":")
@@ -214,7 +213,6 @@ func resourceAliCloudArmsPrometheusMonitoringUpdate(d *schema.ResourceData, meta
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("status")
 	}
 
 	d.Partial(false)
@@ -9,8 +9,8 @@ import (
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudArmsSyntheticTask() *schema.Resource {
@@ -1808,9 +1808,6 @@ func resourceAliCloudArmsSyntheticTaskUpdate(d *schema.ResourceData, meta interf
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("synthetic_task_name")
-		d.SetPartial("frequency")
-		d.SetPartial("resource_group_id")
 	}
 	update = false
 	action = "ChangeResourceGroup"
@@ -1848,7 +1845,6 @@ func resourceAliCloudArmsSyntheticTaskUpdate(d *schema.ResourceData, meta interf
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("resource_group_id")
 	}
 
 	if d.HasChange("status") {
@@ -1931,7 +1927,6 @@ func resourceAliCloudArmsSyntheticTaskUpdate(d *schema.ResourceData, meta interf
 		if err := armsServiceV2.SetResourceTags(d, "SYNTHETICTASK"); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 	d.Partial(false)
 	return resourceAliCloudArmsSyntheticTaskRead(d, meta)
@@ -7,9 +7,9 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlicloudBastionhostHost() *schema.Resource {
@@ -196,7 +196,6 @@ func resourceAlicloudBastionhostHostUpdate(d *schema.ResourceData, meta interfac
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("active_address_type")
 	}
 	update = false
 	modifyHostReq := map[string]interface{}{
@@ -248,11 +247,6 @@ func resourceAlicloudBastionhostHostUpdate(d *schema.ResourceData, meta interfac
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("comment")
-		d.SetPartial("host_name")
-		d.SetPartial("host_private_address")
-		d.SetPartial("host_public_address")
-		d.SetPartial("os_type")
 	}
 	d.Partial(false)
 	return resourceAlicloudBastionhostHostRead(d, meta)
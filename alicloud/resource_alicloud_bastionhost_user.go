@@ -7,9 +7,9 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlicloudBastionhostUser() *schema.Resource {
@@ -261,12 +261,6 @@ func resourceAlicloudBastionhostUserUpdate(d *schema.ResourceData, meta interfac
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("comment")
-		d.SetPartial("display_name")
-		d.SetPartial("email")
-		d.SetPartial("mobile")
-		d.SetPartial("mobile_country_code")
-		d.SetPartial("password")
 	}
 	if d.HasChange("status") {
 		object, err := yundunBastionhostService.DescribeBastionhostUser(d.Id())
@@ -323,7 +317,6 @@ func resourceAlicloudBastionhostUserUpdate(d *schema.ResourceData, meta interfac
 					return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 				}
 			}
-			d.SetPartial("status")
 		}
 	}
 	d.Partial(false)
@@ -9,14 +9,14 @@ import (
 	"github.com/agiledragon/gomonkey/v2"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/alibabacloud-go/tea-rpc/client"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestAccAliCloudCDNRealTimeLogDelivery_basic0(t *testing.T) {
@@ -99,7 +99,7 @@ resource "alicloud_log_store" "default" {
 }
 
 func TestUnitAlicloudCDNRealTimeLogDelivery(t *testing.T) {
-	p := Provider().(*schema.Provider).ResourcesMap
+	p := Provider().ResourcesMap
 	d, _ := schema.InternalMap(p["alicloud_cdn_real_time_log_delivery"].Schema).Data(nil, nil)
 	dCreate, _ := schema.InternalMap(p["alicloud_cdn_real_time_log_delivery"].Schema).Data(nil, nil)
 	dCreate.MarkNewResource()
@@ -7,8 +7,8 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudCenInstance() *schema.Resource {
@@ -226,10 +226,6 @@ func resourceAliCloudCenInstanceUpdate(d *schema.ResourceData, meta interface{})
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("protection_level")
-		d.SetPartial("cen_instance_name")
-		d.SetPartial("description")
-		d.SetPartial("name")
 	}
 
 	update = false
@@ -273,7 +269,6 @@ func resourceAliCloudCenInstanceUpdate(d *schema.ResourceData, meta interface{})
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
 
-		d.SetPartial("resource_group_id")
 	}
 
 	if !d.IsNewResource() && d.HasChange("tags") {
@@ -281,7 +276,6 @@ func resourceAliCloudCenInstanceUpdate(d *schema.ResourceData, meta interface{})
 			return WrapError(err)
 		}
 
-		d.SetPartial("tags")
 	}
 
 	d.Partial(false)
@@ -7,8 +7,8 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudCenTransitRouter() *schema.Resource {
@@ -186,7 +186,6 @@ func resourceAlicloudCenTransitRouterUpdate(d *schema.ResourceData, meta interfa
 		if err := cbnService.SetResourceTags(d, "TransitRouter"); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 
 	if !d.IsNewResource() && d.HasChange("transit_router_description") {
@@ -227,8 +226,6 @@ func resourceAlicloudCenTransitRouterUpdate(d *schema.ResourceData, meta interfa
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("transit_router_description")
-		d.SetPartial("transit_router_name")
 	}
 
 	d.Partial(false)
@@ -7,8 +7,8 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudCenTransitRouterVbrAttachment() *schema.Resource {
@@ -291,9 +291,6 @@ func resourceAliCloudCenTransitRouterVbrAttachmentUpdate(d *schema.ResourceData,
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("auto_publish_route_enabled")
-		d.SetPartial("transit_router_attachment_name")
-		d.SetPartial("transit_router_attachment_description")
 	}
 
 	if d.HasChange("tags") {
@@ -301,7 +298,6 @@ func resourceAliCloudCenTransitRouterVbrAttachmentUpdate(d *schema.ResourceData,
 			return WrapError(err)
 		}
 
-		d.SetPartial("tags")
 	}
 
 	d.Partial(false)
@@ -6,12 +6,12 @@ import (
 	"regexp"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudCenTransitRouterVpnAttachment() *schema.Resource {
@@ -210,7 +210,6 @@ func resourceAlicloudCenTransitRouterVpnAttachmentUpdate(d *schema.ResourceData,
 		if err := cbnService.SetResourceTags(d, "TransitRouterVpnAttachment"); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 
 	if !d.IsNewResource() && d.HasChange("auto_publish_route_enabled") {
@@ -257,9 +256,6 @@ func resourceAlicloudCenTransitRouterVpnAttachmentUpdate(d *schema.ResourceData,
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("auto_publish_route_enabled")
-		d.SetPartial("transit_router_attachment_description")
-		d.SetPartial("transit_router_attachment_name")
 	}
 
 	d.Partial(false)
@@ -9,9 +9,9 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlicloudClickHouseDbCluster() *schema.Resource {
@@ -40,9 +40,9 @@ func resourceAlicloudClickHouseDbCluster() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"db_cluster_ip_array_attribute": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Removed:  "Field 'db_cluster_ip_array_attribute' has been removed from provider",
+							Type:       schema.TypeString,
+							Optional:   true,
+							Deprecated: "Field 'db_cluster_ip_array_attribute' has been removed from provider",
 						},
 						"db_cluster_ip_array_name": {
 							Type:     schema.TypeString,
@@ -448,7 +448,6 @@ func resourceAlicloudClickHouseDbClusterUpdate(d *schema.ResourceData, meta inte
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("db_cluster_description")
 	}
 	update = false
 	modifyDBClusterMaintainTimeReq := map[string]interface{}{
@@ -482,7 +481,6 @@ func resourceAlicloudClickHouseDbClusterUpdate(d *schema.ResourceData, meta inte
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("maintain_time")
 	}
 	if d.HasChange("db_cluster_access_white_list") {
 
@@ -563,7 +561,6 @@ func resourceAlicloudClickHouseDbClusterUpdate(d *schema.ResourceData, meta inte
 				}
 			}
 		}
-		d.SetPartial("db_cluster_access_white_list")
 	}
 	if d.HasChange("status") {
 		clickhouseService := ClickhouseService{client}
@@ -600,7 +597,6 @@ func resourceAlicloudClickHouseDbClusterUpdate(d *schema.ResourceData, meta inte
 					return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 				}
 			}
-			d.SetPartial("status")
 		}
 		stateConf := BuildStateConf([]string{"RESTARTING"}, []string{"Running"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, clickhouseService.ClickHouseDbClusterStateRefreshFunc(d.Id(), []string{}))
 		if _, err := stateConf.WaitForState(); err != nil {
@@ -666,9 +662,6 @@ func resourceAlicloudClickHouseDbClusterUpdate(d *schema.ResourceData, meta inte
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("db_node_storage")
-		d.SetPartial("db_node_group_count")
-		d.SetPartial("db_cluster_class")
 	}
 	if v, ok := d.GetOk("payment_type"); ok && v.(string) == "Subscription" && d.HasChange("renewal_status") && !d.IsNewResource() {
 		action := "ModifyAutoRenewAttribute"
@@ -703,7 +696,6 @@ func resourceAlicloudClickHouseDbClusterUpdate(d *schema.ResourceData, meta inte
 			if _, err := stateConf.WaitForState(); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
-			d.SetPartial("renewal_status")
 		}
 	}
 
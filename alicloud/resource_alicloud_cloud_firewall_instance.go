@@ -6,8 +6,8 @@ import (
 	"time"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudCloudFirewallInstance() *schema.Resource {
@@ -186,9 +186,9 @@ func resourceAliCloudCloudFirewallInstance() *schema.Resource {
 				Computed: true,
 			},
 			"cfw_service": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Removed:  "Attribute 'cfw_service' does not support longer, and it has been removed since v1.209.1",
+				Type:       schema.TypeBool,
+				Optional:   true,
+				Deprecated: "Attribute 'cfw_service' does not support longer, and it has been removed since v1.209.1",
 			},
 		},
 	}
@@ -464,10 +464,6 @@ func resourceAliCloudCloudFirewallInstanceUpdate(d *schema.ResourceData, meta in
 			return WrapError(fmt.Errorf("%s failed, response: %v", action, response))
 		}
 
-		d.SetPartial("renewal_status")
-		d.SetPartial("payment_type")
-		d.SetPartial("renewal_duration")
-		d.SetPartial("renewal_duration_unit")
 	}
 
 	update = false
@@ -640,14 +636,6 @@ func resourceAliCloudCloudFirewallInstanceUpdate(d *schema.ResourceData, meta in
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("payment_type")
-		d.SetPartial("fw_vpc_number")
-		d.SetPartial("ip_number")
-		d.SetPartial("cfw_log_storage")
-		d.SetPartial("cfw_log")
-		d.SetPartial("band_width")
-		d.SetPartial("spec")
-		d.SetPartial("instance_count")
 	}
 
 	if d.HasChange("cfw_log") && d.Get("cfw_log") == true && isPostPaid {
@@ -676,7 +664,6 @@ func resourceAliCloudCloudFirewallInstanceUpdate(d *schema.ResourceData, meta in
 			return WrapErrorf(err, DefaultErrorMsg, "alicloud_cloud_firewall_instance", action, AlibabaCloudSdkGoERROR)
 		}
 
-		d.SetPartial("cfw_log")
 	}
 
 	d.Partial(false)
@@ -7,8 +7,8 @@ import (
 
 	"github.com/PaesslerAG/jsonpath"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudCloudFirewallVpcFirewallCen() *schema.Resource {
@@ -392,9 +392,6 @@ func resourceAliCloudCloudFirewallVpcFirewallCenUpdate(d *schema.ResourceData, m
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
 
-		d.SetPartial("vpc_firewall_name")
-		d.SetPartial("member_uid")
-		d.SetPartial("lang")
 	}
 
 	update = false
@@ -446,9 +443,6 @@ func resourceAliCloudCloudFirewallVpcFirewallCenUpdate(d *schema.ResourceData, m
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("status")
-		d.SetPartial("member_uid")
-		d.SetPartial("lang")
 	}
 
 	d.Partial(false)
@@ -4,7 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"hash/crc32"
 	"log"
 	"regexp"
@@ -13,8 +13,8 @@ import (
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudCloudSsoAccessConfiguration() *schema.Resource {
@@ -98,7 +98,7 @@ func resourceAliCloudCloudSsoAccessConfiguration() *schema.Resource {
 						"permission_policy_document": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validation.ValidateJsonString,
+							ValidateFunc: validation.StringIsJSON,
 						},
 					},
 				},
@@ -293,9 +293,6 @@ func resourceAliCloudCloudSsoAccessConfigurationUpdate(d *schema.ResourceData, m
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
 
-		d.SetPartial("session_duration")
-		d.SetPartial("relay_state")
-		d.SetPartial("description")
 	}
 
 	if d.HasChange("permission_policies") {
@@ -400,7 +397,6 @@ func resourceAliCloudCloudSsoAccessConfigurationUpdate(d *schema.ResourceData, m
 			}
 		}
 
-		d.SetPartial("permission_policies")
 	}
 
 	d.Partial(false)
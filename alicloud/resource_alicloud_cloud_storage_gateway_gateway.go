@@ -7,8 +7,8 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudCloudStorageGatewayGateway() *schema.Resource {
@@ -291,8 +291,6 @@ func resourceAliCloudCloudStorageGatewayGatewayUpdate(d *schema.ResourceData, me
 			return WrapError(fmt.Errorf("%s failed, response: %v", action, response))
 		}
 
-		d.SetPartial("gateway_name")
-		d.SetPartial("description")
 	}
 
 	update = false
@@ -343,7 +341,6 @@ func resourceAliCloudCloudStorageGatewayGatewayUpdate(d *schema.ResourceData, me
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("gateway_class")
 	}
 
 	update = false
@@ -394,7 +391,6 @@ func resourceAliCloudCloudStorageGatewayGatewayUpdate(d *schema.ResourceData, me
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("public_network_bandwidth")
 	}
 
 	d.Partial(false)
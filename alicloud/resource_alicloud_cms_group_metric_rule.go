@@ -7,8 +7,8 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudCmsGroupMetricRule() *schema.Resource {
@@ -732,18 +732,6 @@ func resourceAliCloudCmsGroupMetricRuleUpdate(d *schema.ResourceData, meta inter
 			return WrapError(fmt.Errorf("%s failed, response: %v", action, response))
 		}
 
-		d.SetPartial("group_id")
-		d.SetPartial("group_metric_rule_name")
-		d.SetPartial("metric_name")
-		d.SetPartial("contact_groups")
-		d.SetPartial("dimensions")
-		d.SetPartial("email_subject")
-		d.SetPartial("effective_interval")
-		d.SetPartial("no_effective_interval")
-		d.SetPartial("period")
-		d.SetPartial("silence_time")
-		d.SetPartial("webhook")
-		d.SetPartial("escalations")
 	}
 
 	update = false
@@ -813,7 +801,6 @@ func resourceAliCloudCmsGroupMetricRuleUpdate(d *schema.ResourceData, meta inter
 			return WrapError(fmt.Errorf("%s failed, response: %v", action, response))
 		}
 
-		d.SetPartial("targets")
 	}
 
 	d.Partial(false)
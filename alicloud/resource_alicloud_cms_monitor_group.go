@@ -7,8 +7,8 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudCmsMonitorGroup() *schema.Resource {
@@ -177,14 +177,11 @@ func resourceAlicloudCmsMonitorGroupUpdate(d *schema.ResourceData, meta interfac
 		if !response["Success"].(bool) {
 			return WrapError(Error("ModifyMonitorGroup failed for " + response["Message"].(string)))
 		}
-		d.SetPartial("contact_groups")
-		d.SetPartial("monitor_group_name")
 	}
 	if d.HasChange("tags") {
 		if err := cmsService.SetResourceTags(d, ""); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 	d.Partial(false)
 	return resourceAlicloudCmsMonitorGroupRead(d, meta)
@@ -9,12 +9,12 @@ import (
 	"github.com/agiledragon/gomonkey/v2"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/alibabacloud-go/tea-rpc/client"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 // alicloud_config_configuration_recorder used to open CloudConfig service.
@@ -91,7 +91,7 @@ func ConfigConfigurationRecorderBasicdependence(name string) string {
 }
 
 func TestUnitConfigConfigurationRecorder(t *testing.T) {
-	p := Provider().(*schema.Provider).ResourcesMap
+	p := Provider().ResourcesMap
 	dInit, _ := schema.InternalMap(p["alicloud_config_configuration_recorder"].Schema).Data(nil, nil)
 	dExisted, _ := schema.InternalMap(p["alicloud_config_configuration_recorder"].Schema).Data(nil, nil)
 	dInit.MarkNewResource()
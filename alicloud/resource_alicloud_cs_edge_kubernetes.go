@@ -8,14 +8,14 @@ import (
 
 	aliyungoecs "github.com/denverdino/aliyungo/ecs"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 	"github.com/denverdino/aliyungo/common"
 	"github.com/denverdino/aliyungo/cs"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 const (
@@ -187,18 +187,7 @@ func resourceAlicloudCSEdgeKubernetes() *schema.Resource {
 			"runtime": {
 				Type:     schema.TypeMap,
 				Optional: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-						"version": {
-							Type:     schema.TypeString,
-							Optional: true,
-						},
-					},
-				},
+				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"node_cidr_mask": {
 				Type:         schema.TypeInt,
@@ -310,46 +299,12 @@ func resourceAlicloudCSEdgeKubernetes() *schema.Resource {
 			"certificate_authority": {
 				Type:     schema.TypeMap,
 				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"cluster_cert": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"client_cert": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"client_key": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-					},
-				},
+				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"connections": {
 				Type:     schema.TypeMap,
 				Computed: true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"api_server_internet": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"api_server_intranet": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"master_public_ip": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-						"service_domain": {
-							Type:     schema.TypeString,
-							Computed: true,
-						},
-					},
-				},
+				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"slb_internet": {
 				Type:     schema.TypeString,
@@ -574,7 +529,6 @@ func resourceAlicloudCSEdgeKubernetesUpdate(d *schema.ResourceData, meta interfa
 				if tags, err := ConvertCsTags(d); err == nil {
 					args.Tags = tags
 				}
-				d.SetPartial("tags")
 			}
 
 			if err := invoker.Run(func() error {
@@ -598,12 +552,6 @@ func resourceAlicloudCSEdgeKubernetesUpdate(d *schema.ResourceData, meta interfa
 			if _, err := stateConf.WaitForState(); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
-			d.SetPartial("worker_data_disks")
-			d.SetPartial("worker_number")
-			d.SetPartial("worker_disk_category")
-			d.SetPartial("worker_disk_size")
-			d.SetPartial("worker_disk_snapshot_policy_id")
-			d.SetPartial("worker_disk_performance_level")
 		}
 
 	}
@@ -634,8 +582,6 @@ func resourceAlicloudCSEdgeKubernetesUpdate(d *schema.ResourceData, meta interfa
 			requestMap["ClusterName"] = clusterName
 			addDebug("ModifyClusterName", response, requestInfo, requestMap)
 		}
-		d.SetPartial("name")
-		d.SetPartial("name_prefix")
 	}
 
 	// modify cluster deletion protection
@@ -660,7 +606,6 @@ func resourceAlicloudCSEdgeKubernetesUpdate(d *schema.ResourceData, meta interfa
 			requestMap["deletion_protection"] = requestInfo.DeletionProtection
 			addDebug("ModifyCluster", response, requestInfo, requestMap)
 		}
-		d.SetPartial("deletion_protection")
 	}
 
 	// modify cluster tag
@@ -670,7 +615,6 @@ func resourceAlicloudCSEdgeKubernetesUpdate(d *schema.ResourceData, meta interfa
 			return WrapErrorf(err, ResponseCodeMsg, d.Id(), "ModifyClusterTags", AlibabaCloudSdkGoERROR)
 		}
 	}
-	d.SetPartial("tags")
 
 	// upgrade cluster version
 	err := UpgradeAlicloudKubernetesCluster(d, meta)
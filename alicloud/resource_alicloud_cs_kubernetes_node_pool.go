@@ -18,8 +18,16 @@ import (
 	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 	"github.com/denverdino/aliyungo/common"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"golang.org/x/net/context"
+	v1 "k8s.io/api/core/v1"
+	policyv1beta1 "k8s.io/api/policy/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
 )
 
 func resourceAliCloudAckNodepool() *schema.Resource {
@@ -31,6 +39,10 @@ func resourceAliCloudAckNodepool() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffAll(
+			customizeDiffPeriodOnlyWithPrePaid("instance_charge_type", string(PrePaid), "period", "period_unit", "auto_renew_period"),
+			customizeDiffRequiredWhenValue("spot_strategy", []string{"SpotWithPriceLimit"}, "spot_price_limit"),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
 			Update: schema.DefaultTimeout(60 * time.Minute),
@@ -479,6 +491,12 @@ func resourceAliCloudAckNodepool() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"replacement_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "in_place",
+				ValidateFunc: StringInSlice([]string{"in_place", "blue_green"}, false),
+			},
 			"resource_group_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -778,12 +796,12 @@ func resourceAliCloudAckNodepool() *schema.Resource {
 						},
 					},
 				},
-				Removed: "Field 'rollout_policy' has been removed from provider version 1.184.0. Please use new field 'rolling_policy' instead it to ensure the config takes effect",
+				Deprecated: "Field 'rollout_policy' has been removed from provider version 1.184.0. Please use new field 'rolling_policy' instead it to ensure the config takes effect",
 			},
 			"vpc_id": {
-				Type:     schema.TypeString,
-				Computed: true,
-				Removed:  "Field 'vpc_id' has been removed from provider version 1.218.0.",
+				Type:       schema.TypeString,
+				Computed:   true,
+				Deprecated: "Field 'vpc_id' has been removed from provider version 1.218.0.",
 			},
 			"kms_encryption_context": {
 				Type:     schema.TypeMap,
@@ -1814,10 +1832,16 @@ func resourceAliCloudAckNodepoolRead(d *schema.ResourceData, meta interface{}) e
 	d.Set("cluster_id", parts[0])
 
 	d.Set("name", d.Get("node_pool_name"))
+	if _, ok := d.GetOk("replacement_strategy"); !ok {
+		d.Set("replacement_strategy", "in_place")
+	}
 	return nil
 }
 
 func resourceAliCloudAckNodepoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.Get("replacement_strategy").(string) == "blue_green" && d.HasChanges(ackNodepoolBlueGreenAttributes...) {
+		return resourceAliCloudAckNodepoolBlueGreenUpdate(d, meta)
+	}
 	client := meta.(*connectivity.AliyunClient)
 	var request map[string]interface{}
 	var response map[string]interface{}
@@ -2576,11 +2600,13 @@ func resourceAliCloudAckNodepoolUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceAliCloudAckNodepoolDelete(d *schema.ResourceData, meta interface{}) error {
+	parts := strings.Split(d.Id(), ":")
+	return deleteAckNodepool(d, meta, parts[0], parts[1])
+}
 
+func deleteAckNodepool(d *schema.ResourceData, meta interface{}, ClusterId, NodepoolId string) error {
 	client := meta.(*connectivity.AliyunClient)
-	parts := strings.Split(d.Id(), ":")
-	NodepoolId := parts[1]
-	ClusterId := parts[0]
+	id := fmt.Sprintf("%s:%s", ClusterId, NodepoolId)
 	action := fmt.Sprintf("/clusters/%s/nodepools/%s", ClusterId, NodepoolId)
 	var request map[string]interface{}
 	var response map[string]interface{}
@@ -2617,13 +2643,13 @@ func resourceAliCloudAckNodepoolDelete(d *schema.ResourceData, meta interface{})
 		if NotFoundError(err) {
 			return nil
 		}
-		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
+		return WrapErrorf(err, DefaultErrorMsg, id, action, AlibabaCloudSdkGoERROR)
 	}
 
 	ackServiceV2 := AckServiceV2{client}
 	stateConf := BuildStateConf([]string{}, []string{"success"}, d.Timeout(schema.TimeoutDelete), 5*time.Second, ackServiceV2.DescribeAsyncAckNodepoolStateRefreshFunc(d, response, "$.state", []string{"fail", "failed"}))
 	if jobDetail, err := stateConf.WaitForState(); err != nil {
-		return WrapErrorf(err, IdMsg, d.Id(), jobDetail)
+		return WrapErrorf(err, IdMsg, id, jobDetail)
 	}
 
 	return nil
@@ -2717,8 +2743,6 @@ func removeNodePoolNodes(d *schema.ResourceData, meta interface{}, parseId []str
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	d.SetPartial("node_count")
-
 	return nil
 }
 
@@ -2849,3 +2873,213 @@ func diffInstances(old []string, new []string) (attach []string, remove []string
 
 	return
 }
+
+// ackNodepoolBlueGreenAttributes are the attributes which changes replace the node pool when replacement_strategy is blue_green.
+var ackNodepoolBlueGreenAttributes = []string{"runtime_name", "runtime_version", "image_id", "image_type", "instance_types", "system_disk_category", "system_disk_size", "data_disks"}
+
+// ackNodepoolIdLabel is the node label which ACK uses to mark the node pool of the node.
+const ackNodepoolIdLabel = "alibabacloud.com/nodepool-id"
+
+// resourceAliCloudAckNodepoolBlueGreenUpdate creates a sibling node pool with the new spec, waits for its nodes to be ready,
+// cordons and drains the nodes of the old node pool and then deletes the old node pool.
+func resourceAliCloudAckNodepoolBlueGreenUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	if v, ok := d.GetOk("instances"); ok && len(v.([]interface{})) > 0 {
+		return WrapError(fmt.Errorf("replacement_strategy blue_green can not be used with instances, because the existing instances can not be attached to two node pools"))
+	}
+	parts := strings.Split(d.Id(), ":")
+	ClusterId := parts[0]
+	oldNodepoolId := parts[1]
+	oldId := d.Id()
+	nodepoolName := d.Get("node_pool_name").(string)
+
+	d.Partial(true)
+	// The sibling node pool uses a temporary name, and it is renamed after the old node pool has been deleted.
+	d.Set("node_pool_name", fmt.Sprintf("%s-%s", nodepoolName, strconv.FormatInt(time.Now().Unix(), 36)))
+	if err := resourceAliCloudAckNodepoolCreate(d, meta); err != nil {
+		if d.Id() != oldId {
+			log.Printf("[WARN] The sibling node pool %s of the node pool %s failed to be created, please delete it manually.", d.Id(), oldId)
+		}
+		d.SetId(oldId)
+		d.Set("node_pool_name", nodepoolName)
+		return WrapError(err)
+	}
+	newNodepoolId := strings.Split(d.Id(), ":")[1]
+
+	clientSet, err := ackKubernetesClientSet(client, ClusterId)
+	if err != nil {
+		return WrapErrorf(err, "the sibling node pool %s has been created, but the old node pool %s has not been drained and deleted", d.Id(), oldId)
+	}
+	stateConf := BuildStateConf([]string{"NotReady"}, []string{"Ready"}, d.Timeout(schema.TimeoutUpdate), 10*time.Second, ackNodepoolNodesReadyRefreshFunc(client, clientSet, ClusterId, newNodepoolId))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, "the nodes of the sibling node pool %s are not ready, and the old node pool %s has not been drained and deleted", d.Id(), oldId)
+	}
+	if err := drainAckNodepoolNodes(clientSet, oldNodepoolId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return WrapErrorf(err, "the old node pool %s failed to be drained, please drain and delete it manually", oldId)
+	}
+	if err := deleteAckNodepool(d, meta, ClusterId, oldNodepoolId); err != nil {
+		return WrapErrorf(err, "the old node pool %s failed to be deleted, please delete it manually", oldId)
+	}
+
+	if err := renameAckNodepool(d, meta, ClusterId, newNodepoolId, nodepoolName); err != nil {
+		return WrapError(err)
+	}
+	d.Set("node_pool_name", nodepoolName)
+	d.Partial(false)
+	return resourceAliCloudAckNodepoolRead(d, meta)
+}
+
+func renameAckNodepool(d *schema.ResourceData, meta interface{}, ClusterId, NodepoolId, name string) error {
+	client := meta.(*connectivity.AliyunClient)
+	action := fmt.Sprintf("/clusters/%s/nodepools/%s", ClusterId, NodepoolId)
+	var response map[string]interface{}
+	conn, err := client.NewAckClient()
+	if err != nil {
+		return WrapError(err)
+	}
+	request := map[string]interface{}{
+		"nodepool_info": map[string]interface{}{
+			"name": name,
+		},
+	}
+	runtime := util.RuntimeOptions{}
+	runtime.SetAutoretry(true)
+	wait := incrementalWait(3*time.Second, 5*time.Second)
+	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		response, err = conn.DoRequest(StringPointer("2015-12-15"), nil, StringPointer("PUT"), StringPointer("AK"), StringPointer(action), nil, nil, request, &runtime)
+		if err != nil {
+			if NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
+	}
+	ackServiceV2 := AckServiceV2{client}
+	stateConf := BuildStateConf([]string{}, []string{"success"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, ackServiceV2.DescribeAsyncAckNodepoolStateRefreshFunc(d, response, "$.state", []string{"fail", "failed"}))
+	if jobDetail, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id(), jobDetail)
+	}
+	return nil
+}
+
+// ackKubernetesClientSet builds the client of the cluster API with a temporary kubeconfig, which is never written to the disk.
+func ackKubernetesClientSet(client *connectivity.AliyunClient, clusterId string) (*kubernetes.Clientset, error) {
+	roaClient, err := client.NewRoaCsClient()
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	csClient := CsClient{roaClient}
+	kubeConfig, err := csClient.DescribeClusterKubeConfigWithExpiration(clusterId, 60)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	config, err := clientcmd.RESTConfigFromKubeConfig([]byte(tea.StringValue(kubeConfig.Config)))
+	if err != nil {
+		return nil, WrapError(fmt.Errorf("failed to parse the kubeconfig of the cluster %s: %v", clusterId, err))
+	}
+	clientSet, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, WrapError(fmt.Errorf("failed to create the client of the cluster %s: %v", clusterId, err))
+	}
+	return clientSet, nil
+}
+
+// ackNodepoolNodesReadyRefreshFunc returns Ready once all the nodes of the node pool have joined the cluster and are ready.
+func ackNodepoolNodesReadyRefreshFunc(client *connectivity.AliyunClient, clientSet *kubernetes.Clientset, clusterId, nodepoolId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		var nodes []cs.KubernetesNodeType
+		for pageNumber := 1; ; pageNumber++ {
+			raw, err := client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
+				nodes, _, err := csClient.GetKubernetesClusterNodes(clusterId, common.Pagination{PageNumber: pageNumber, PageSize: PageSizeLarge}, nodepoolId)
+				return nodes, err
+			})
+			if err != nil {
+				return nil, "", WrapErrorf(err, DefaultErrorMsg, clusterId, "GetKubernetesClusterNodes", DenverdinoAliyungo)
+			}
+			page := raw.([]cs.KubernetesNodeType)
+			nodes = append(nodes, page...)
+			if len(page) < PageSizeLarge {
+				break
+			}
+		}
+
+		k8sNodes, err := clientSet.CoreV1().Nodes().List(context.Background(), metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", ackNodepoolIdLabel, nodepoolId)})
+		if err != nil {
+			return nil, "", WrapError(err)
+		}
+		ready := 0
+		for _, node := range k8sNodes.Items {
+			for _, condition := range node.Status.Conditions {
+				if condition.Type == v1.NodeReady && condition.Status == v1.ConditionTrue {
+					ready++
+				}
+			}
+		}
+		if ready < len(nodes) {
+			return k8sNodes, "NotReady", nil
+		}
+		return k8sNodes, "Ready", nil
+	}
+}
+
+// drainAckNodepoolNodes cordons the nodes of the node pool and evicts their pods except the ones managed by DaemonSets and the mirror pods.
+func drainAckNodepoolNodes(clientSet *kubernetes.Clientset, nodepoolId string, timeout time.Duration) error {
+	ctx := context.Background()
+	nodes, err := clientSet.CoreV1().Nodes().List(ctx, metav1.ListOptions{LabelSelector: fmt.Sprintf("%s=%s", ackNodepoolIdLabel, nodepoolId)})
+	if err != nil {
+		return WrapError(err)
+	}
+	for _, node := range nodes.Items {
+		if node.Spec.Unschedulable {
+			continue
+		}
+		if _, err := clientSet.CoreV1().Nodes().Patch(ctx, node.Name, types.StrategicMergePatchType, []byte(`{"spec":{"unschedulable":true}}`), metav1.PatchOptions{}); err != nil {
+			return WrapError(fmt.Errorf("failed to cordon the node %s: %v", node.Name, err))
+		}
+	}
+
+	return resource.Retry(timeout, func() *resource.RetryError {
+		remaining := 0
+		for _, node := range nodes.Items {
+			pods, err := clientSet.CoreV1().Pods(metav1.NamespaceAll).List(ctx, metav1.ListOptions{FieldSelector: fmt.Sprintf("spec.nodeName=%s", node.Name)})
+			if err != nil {
+				return resource.NonRetryableError(WrapError(err))
+			}
+			for _, pod := range pods.Items {
+				if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+					continue
+				}
+				if _, ok := pod.Annotations[v1.MirrorPodAnnotationKey]; ok {
+					continue
+				}
+				if controller := metav1.GetControllerOf(&pod); controller != nil && controller.Kind == "DaemonSet" {
+					continue
+				}
+				remaining++
+				if pod.DeletionTimestamp != nil {
+					continue
+				}
+				eviction := &policyv1beta1.Eviction{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      pod.Name,
+						Namespace: pod.Namespace,
+					},
+				}
+				// The eviction is rejected with TooManyRequests when it violates a PodDisruptionBudget, and it will be retried.
+				if err := clientSet.CoreV1().Pods(pod.Namespace).Evict(ctx, eviction); err != nil && !errors.IsNotFound(err) && !errors.IsTooManyRequests(err) {
+					return resource.NonRetryableError(WrapError(fmt.Errorf("failed to evict the pod %s/%s: %v", pod.Namespace, pod.Name, err)))
+				}
+			}
+		}
+		if remaining > 0 {
+			return resource.RetryableError(fmt.Errorf("waiting for %d pods to be evicted from the node pool %s", remaining, nodepoolId))
+		}
+		return nil
+	})
+}
@@ -4,11 +4,11 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 	"github.com/denverdino/aliyungo/cs"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestAccAliCloudCSKubernetesNodePool_basic(t *testing.T) {
@@ -1198,6 +1198,81 @@ func TestAccAliCloudCSKubernetesNodePool_AttachInstances(t *testing.T) {
 	})
 }
 
+func TestAccAliCloudCSKubernetesNodePool_BlueGreen(t *testing.T) {
+	var v *cs.NodePoolDetail
+
+	resourceId := "alicloud_cs_kubernetes_node_pool.default"
+	ra := resourceAttrInit(resourceId, map[string]string{})
+
+	serviceFunc := func() interface{} {
+		return &CsService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+
+	rac := resourceAttrCheckInit(rc, ra)
+
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testAccNodePool-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceCSNodePoolConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		// module name
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"node_pool_name":       name,
+					"cluster_id":           "${local.cluster_id}",
+					"vswitch_ids":          []string{"${local.vswitch_id}"},
+					"instance_types":       []string{"${data.alicloud_instance_types.default.instance_types.0.id}"},
+					"desired_size":         "1",
+					"key_name":             "${alicloud_key_pair.default.key_name}",
+					"system_disk_category": "cloud_efficiency",
+					"system_disk_size":     "40",
+					"image_type":           "AliyunLinux3",
+					"replacement_strategy": "blue_green",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"node_pool_name":       name,
+						"node_pool_id":         CHECKSET,
+						"instance_types.#":     "1",
+						"system_disk_size":     "40",
+						"replacement_strategy": "blue_green",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "replacement_strategy"},
+			},
+			// replace the node pool with a sibling one
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"instance_types":   []string{"${data.alicloud_instance_types.default.instance_types.1.id}"},
+					"system_disk_size": "60",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"node_pool_name":   name,
+						"node_pool_id":     CHECKSET,
+						"instance_types.#": "1",
+						"system_disk_size": "60",
+					}),
+				),
+			},
+		},
+	})
+}
+
 // auto_scaling has concurrent config conflict
 func SkipTestAccAliCloudCSKubernetesNodePool_ScalingConflict(t *testing.T) {
 	var v *cs.NodePoolDetail
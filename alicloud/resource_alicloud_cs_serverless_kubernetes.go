@@ -10,8 +10,8 @@ import (
 	"github.com/alibabacloud-go/tea/tea"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudCSServerlessKubernetes() *schema.Resource {
@@ -51,10 +51,10 @@ func resourceAlicloudCSServerlessKubernetes() *schema.Resource {
 				ConflictsWith: []string{"zone_id"},
 			},
 			"vswitch_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-				Removed:  "Field 'vswitch_id' has been removed from provider version 1.229.1. New field 'vswitch_ids' replace it.",
+				Type:       schema.TypeString,
+				Optional:   true,
+				Computed:   true,
+				Deprecated: "Field 'vswitch_id' has been removed from provider version 1.229.1. New field 'vswitch_ids' replace it.",
 			},
 			"vswitch_ids": {
 				Type:     schema.TypeList,
@@ -135,9 +135,9 @@ func resourceAlicloudCSServerlessKubernetes() *schema.Resource {
 				Optional: true,
 			},
 			"force_update": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Removed:  "Field 'force_update' has been removed from provider version 1.229.1.",
+				Type:       schema.TypeBool,
+				Optional:   true,
+				Deprecated: "Field 'force_update' has been removed from provider version 1.229.1.",
 			},
 			"security_group_id": {
 				Type:     schema.TypeString,
@@ -218,15 +218,14 @@ func resourceAlicloudCSServerlessKubernetes() *schema.Resource {
 				ValidateFunc: StringInSlice([]string{"ack.standard", "ack.pro.small"}, false),
 			},
 			"create_v2_cluster": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				Computed: true,
-				Removed:  "Field 'create_v2_cluster' has been removed from provider version 1.229.1.",
+				Type:       schema.TypeBool,
+				Optional:   true,
+				Computed:   true,
+				Deprecated: "Field 'create_v2_cluster' has been removed from provider version 1.229.1.",
 			},
 			"rrsa_metadata": {
 				Type:     schema.TypeList,
 				Computed: true,
-				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"enabled": {
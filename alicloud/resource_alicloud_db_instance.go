@@ -17,8 +17,8 @@ import (
 	"strconv"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudDBInstance() *schema.Resource {
@@ -30,6 +30,10 @@ func resourceAliCloudDBInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffAll(
+			customizeDiffPeriodOnlyWithPrePaid("instance_charge_type", string(Prepaid), "period"),
+			customizeDiffRequiredWhenValue("ha_config", []string{"Manual"}, "manual_ha_time"),
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(50 * time.Minute),
@@ -761,9 +765,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		addDebug(action, response, request)
 
 		stateConf = BuildStateConf([]string{}, []string{"Running"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
-		d.SetPartial("storage_auto_scale")
-		d.SetPartial("storage_threshold")
-		d.SetPartial("storage_upper_bound")
 		// wait instance status is running after modifying
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
@@ -798,8 +799,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("instance_charge_type")
-		d.SetPartial("period")
 
 	}
 
@@ -826,8 +825,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("auto_renew")
-		d.SetPartial("auto_renew_period")
 	}
 
 	if d.HasChange("security_group_ids") || d.HasChange("security_group_id") {
@@ -843,8 +840,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("security_group_ids")
-		d.SetPartial("security_group_id")
 	}
 
 	if d.HasChange("monitoring_period") {
@@ -887,7 +882,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("maintain_time")
 	}
 	if d.HasChange("auto_upgrade_minor_version") {
 		action := "ModifyDBInstanceAutoUpgradeMinorVersion"
@@ -909,7 +903,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("auto_upgrade_minor_version")
 	}
 
 	if !d.IsNewResource() && d.HasChange("engine_version") && d.Get("engine").(string) == string(MySQL) {
@@ -940,8 +933,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		}
 		addDebug(action, response, request)
 		stateConf := BuildStateConf([]string{}, []string{"Running"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
-		d.SetPartial("engine_version")
-		d.SetPartial("effective_time")
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
@@ -963,7 +954,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("security_ip_mode")
 	}
 
 	if d.HasChange("sql_collector_status") {
@@ -988,7 +978,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("sql_collector_status")
 	}
 
 	if d.Get("sql_collector_status").(string) == "Enabled" && d.HasChange("sql_collector_config_value") && d.Get("engine").(string) == string(MySQL) {
@@ -1008,7 +997,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("sql_collector_config_value")
 	}
 
 	if d.HasChanges("ssl_action", "ssl_connection_string") {
@@ -1133,8 +1121,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("ssl_action")
-		d.SetPartial("ssl_connection_string")
 		// wait instance status is running after modifying
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
@@ -1171,7 +1157,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
 		addDebug(action, response, request)
-		d.SetPartial("tde_status")
 
 		// wait instance status is running after modifying
 		if _, err := stateConf.WaitForState(); err != nil {
@@ -1220,8 +1205,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		if _, err := stateConfNodeId.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("node_id")
-		d.SetPartial("force")
 	}
 	if d.HasChanges("ha_config", "manual_ha_time") {
 		action := "ModifyHASwitchConfig"
@@ -1255,8 +1238,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		}
 		addDebug(action, response, request)
 		stateConf := BuildStateConf([]string{}, []string{"Running"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
-		d.SetPartial("ha_config")
-		d.SetPartial("manual_ha_time")
 		// wait instance status is running after modifying
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
@@ -1315,9 +1296,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), connectAction, AlibabaCloudSdkGoERROR)
 			}
 			addDebug(connectAction, response, connectRequest)
-			d.SetPartial("port")
-			d.SetPartial("connection_string")
-			d.SetPartial("babelfish_port")
 			// wait instance status is running after modifying
 			stateConf := BuildStateConf([]string{}, []string{"Running"}, d.Timeout(schema.TimeoutUpdate), 1*time.Minute, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
 			if _, err := stateConf.WaitForState(); err != nil {
@@ -1348,7 +1326,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("instance_name")
 	}
 
 	if d.HasChange("security_ips") {
@@ -1406,11 +1383,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("security_ips")
-		d.SetPartial("db_instance_ip_array_name")
-		d.SetPartial("db_instance_ip_array_attribute")
-		d.SetPartial("security_ip_type")
-		d.SetPartial("whitelist_network_type")
 	}
 	if !d.IsNewResource() && d.HasChange("resource_group_id") {
 		action := "ModifyResourceGroup"
@@ -1429,7 +1401,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("resource_group_id")
 	}
 	update := false
 	action := "ModifyDBInstanceSpec"
@@ -1518,11 +1489,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 				return resource.NonRetryableError(err)
 			}
 			addDebug(action, response, request)
-			d.SetPartial("instance_type")
-			d.SetPartial("instance_storage")
-			d.SetPartial("db_instance_storage_type")
-			d.SetPartial("effective_time")
-			d.SetPartial("serverless_config")
 			return nil
 		})
 
@@ -1584,8 +1550,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("vswitch_id")
-		d.SetPartial("private_ip_address")
 
 		// wait instance status is running after modifying
 		if _, err := stateConf.WaitForState(); err != nil {
@@ -1694,7 +1658,6 @@ func resourceAliCloudDBInstanceUpdate(d *schema.ResourceData, meta interface{})
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("target_minor_version")
 		// wait instance status is running after modifying
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
@@ -10,13 +10,13 @@ import (
 	"github.com/alibabacloud-go/tea-rpc/client"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestAccAlicloudDdosBasicDefenseThreshold_basic0(t *testing.T) {
@@ -112,7 +112,7 @@ resource "alicloud_eip_address" "default" {
 }
 
 func TestUnitAlicloudDdosBasicDefenseThreshold(t *testing.T) {
-	p := Provider().(*schema.Provider).ResourcesMap
+	p := Provider().ResourcesMap
 	dInit, _ := schema.InternalMap(p["alicloud_ddos_basic_defense_threshold"].Schema).Data(nil, nil)
 	dExisted, _ := schema.InternalMap(p["alicloud_ddos_basic_defense_threshold"].Schema).Data(nil, nil)
 	dInit.MarkNewResource()
@@ -12,16 +12,16 @@ import (
 	"github.com/agiledragon/gomonkey/v2"
 	"github.com/alibabacloud-go/tea-rpc/client"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func init() {
@@ -287,7 +287,7 @@ resource "alicloud_dts_migration_instance" "default" {
 }
 
 func TestUnitAlicloudDTSMigrationJob(t *testing.T) {
-	p := Provider().(*schema.Provider).ResourcesMap
+	p := Provider().ResourcesMap
 	d, _ := schema.InternalMap(p["alicloud_dts_migration_job"].Schema).Data(nil, nil)
 	dCreate, _ := schema.InternalMap(p["alicloud_dts_migration_job"].Schema).Data(nil, nil)
 	dCreate.MarkNewResource()
@@ -552,7 +552,7 @@ func TestUnitAlicloudDTSMigrationJob(t *testing.T) {
 
 	t.Run("UpdateModifyDtsMigrationJobSuspendingStatusAbnormal", func(t *testing.T) {
 		diff := terraform.NewInstanceDiff()
-		diff.SetAttribute("status", &terraform.ResourceAttrDiff{Old: "Migrating", New: "Suspending"})
+		diff.Attributes["status"] = &terraform.ResourceAttrDiff{Old: "Migrating", New: "Suspending"}
 		resourceData1, _ := schema.InternalMap(p["alicloud_dts_migration_job"].Schema).Data(nil, diff)
 		resourceData1.SetId(d.Id())
 		retryFlag := true
@@ -577,7 +577,7 @@ func TestUnitAlicloudDTSMigrationJob(t *testing.T) {
 	})
 	t.Run("UpdateModifyDTSMigrationJobSuspendingStatusNoRetryErrorAbnormal", func(t *testing.T) {
 		diff := terraform.NewInstanceDiff()
-		diff.SetAttribute("status", &terraform.ResourceAttrDiff{Old: "Migrating", New: "Suspending"})
+		diff.Attributes["status"] = &terraform.ResourceAttrDiff{Old: "Migrating", New: "Suspending"}
 		resourceData, _ := schema.InternalMap(p["alicloud_dts_migration_job"].Schema).Data(nil, diff)
 		resourceData.SetId(d.Id())
 		patcheDescribe := gomonkey.ApplyMethod(reflect.TypeOf(&DtsService{}), "DescribeDtsMigrationJob", func(*DtsService, string) (map[string]interface{}, error) {
@@ -593,7 +593,7 @@ func TestUnitAlicloudDTSMigrationJob(t *testing.T) {
 	})
 	t.Run("UpdateModifyDTSMigrationJobSuspendingStatusNoRetryErrorNormal", func(t *testing.T) {
 		diff := terraform.NewInstanceDiff()
-		diff.SetAttribute("status", &terraform.ResourceAttrDiff{Old: "Migrating", New: "Suspending"})
+		diff.Attributes["status"] = &terraform.ResourceAttrDiff{Old: "Migrating", New: "Suspending"}
 		resourceData, _ := schema.InternalMap(p["alicloud_dts_migration_job"].Schema).Data(nil, diff)
 		resourceData.SetId(d.Id())
 		patcheDescribe := gomonkey.ApplyMethod(reflect.TypeOf(&DtsService{}), "DescribeDtsMigrationJob", func(*DtsService, string) (map[string]interface{}, error) {
@@ -609,7 +609,7 @@ func TestUnitAlicloudDTSMigrationJob(t *testing.T) {
 	})
 	t.Run("UpdateModifyDtsMigrationJobMigratingStatusAbnormal", func(t *testing.T) {
 		diff := terraform.NewInstanceDiff()
-		diff.SetAttribute("status", &terraform.ResourceAttrDiff{Old: "Suspending", New: "Migrating"})
+		diff.Attributes["status"] = &terraform.ResourceAttrDiff{Old: "Suspending", New: "Migrating"}
 		resourceData1, _ := schema.InternalMap(p["alicloud_dts_migration_job"].Schema).Data(nil, diff)
 		resourceData1.SetId(d.Id())
 		retryFlag := true
@@ -634,7 +634,7 @@ func TestUnitAlicloudDTSMigrationJob(t *testing.T) {
 	})
 	t.Run("UpdateModifyDTSMigrationJobMigratingStatusNoRetryErrorAbnormal", func(t *testing.T) {
 		diff := terraform.NewInstanceDiff()
-		diff.SetAttribute("status", &terraform.ResourceAttrDiff{Old: "Suspending", New: "Migrating"})
+		diff.Attributes["status"] = &terraform.ResourceAttrDiff{Old: "Suspending", New: "Migrating"}
 		resourceData, _ := schema.InternalMap(p["alicloud_dts_migration_job"].Schema).Data(nil, diff)
 		resourceData.SetId(d.Id())
 		patcheDescribe := gomonkey.ApplyMethod(reflect.TypeOf(&DtsService{}), "DescribeDtsMigrationJob", func(*DtsService, string) (map[string]interface{}, error) {
@@ -650,7 +650,7 @@ func TestUnitAlicloudDTSMigrationJob(t *testing.T) {
 	})
 	t.Run("UpdateModifyDTSMigrationJobMigratingStatusNoRetryErrorNormal", func(t *testing.T) {
 		diff := terraform.NewInstanceDiff()
-		diff.SetAttribute("status", &terraform.ResourceAttrDiff{Old: "Suspending", New: "Migrating"})
+		diff.Attributes["status"] = &terraform.ResourceAttrDiff{Old: "Suspending", New: "Migrating"}
 		resourceData, _ := schema.InternalMap(p["alicloud_dts_migration_job"].Schema).Data(nil, diff)
 		resourceData.SetId(d.Id())
 		patcheDescribe := gomonkey.ApplyMethod(reflect.TypeOf(&DtsService{}), "DescribeDtsMigrationJob", func(*DtsService, string) (map[string]interface{}, error) {
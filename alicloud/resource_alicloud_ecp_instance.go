@@ -8,8 +8,8 @@ import (
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudEcpInstance() *schema.Resource {
@@ -311,10 +311,6 @@ func resourceAliCloudEcpInstanceUpdate(d *schema.ResourceData, meta interface{})
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
 
-		d.SetPartial("key_pair_name")
-		d.SetPartial("vnc_password")
-		d.SetPartial("instance_name")
-		d.SetPartial("description")
 	}
 
 	update = false
@@ -376,7 +372,6 @@ func resourceAliCloudEcpInstanceUpdate(d *schema.ResourceData, meta interface{})
 			}
 		}
 
-		d.SetPartial("resolution")
 	}
 
 	if d.HasChange("status") {
@@ -402,7 +397,6 @@ func resourceAliCloudEcpInstanceUpdate(d *schema.ResourceData, meta interface{})
 			}
 		}
 
-		d.SetPartial("status")
 	}
 
 	d.Partial(false)
@@ -8,9 +8,9 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlicloudEcsDedicatedHost() *schema.Resource {
@@ -310,7 +310,6 @@ func resourceAlicloudEcsDedicatedHostUpdate(d *schema.ResourceData, meta interfa
 		if err := ecsService.SetResourceTags(d, "ddh"); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 	if !d.IsNewResource() && d.HasChange("auto_release_time") {
 		request := map[string]interface{}{
@@ -339,7 +338,6 @@ func resourceAlicloudEcsDedicatedHostUpdate(d *schema.ResourceData, meta interfa
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("auto_release_time")
 	}
 	if !d.IsNewResource() && d.HasChange("resource_group_id") {
 		request := map[string]interface{}{
@@ -369,7 +367,6 @@ func resourceAlicloudEcsDedicatedHostUpdate(d *schema.ResourceData, meta interfa
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("resource_group_id")
 	}
 	update := false
 	request := map[string]interface{}{
@@ -406,8 +403,6 @@ func resourceAlicloudEcsDedicatedHostUpdate(d *schema.ResourceData, meta interfa
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("expired_time")
-		d.SetPartial("sale_cycle")
 	}
 	update = false
 	modifyDedicatedHostsChargeTypeReq := map[string]interface{}{
@@ -449,11 +444,6 @@ func resourceAlicloudEcsDedicatedHostUpdate(d *schema.ResourceData, meta interfa
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("detail_fee")
-		d.SetPartial("dry_run")
-		d.SetPartial("expired_time")
-		d.SetPartial("payment_type")
-		d.SetPartial("sale_cycle")
 	}
 	update = false
 	modifyDedicatedHostAttributeReq := map[string]interface{}{
@@ -517,13 +507,6 @@ func resourceAlicloudEcsDedicatedHostUpdate(d *schema.ResourceData, meta interfa
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("action_on_maintenance")
-		d.SetPartial("auto_placement")
-		d.SetPartial("cpu_over_commit_ratio")
-		d.SetPartial("dedicated_host_cluster_id")
-		d.SetPartial("dedicated_host_name")
-		d.SetPartial("description")
-		d.SetPartial("network_attributes")
 	}
 	d.Partial(false)
 	return resourceAlicloudEcsDedicatedHostRead(d, meta)
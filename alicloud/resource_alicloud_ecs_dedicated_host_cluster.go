@@ -6,12 +6,12 @@ import (
 	"regexp"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudEcsDedicatedHostCluster() *schema.Resource {
@@ -134,7 +134,6 @@ func resourceAlicloudEcsDedicatedHostClusterUpdate(d *schema.ResourceData, meta
 		if err := ecsService.SetResourceTags(d, "ddhcluster"); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 	if d.HasChange("dedicated_host_cluster_name") {
 		update = true
@@ -9,8 +9,8 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudEcsDisk() *schema.Resource {
@@ -183,9 +183,9 @@ func resourceAliCloudEcsDisk() *schema.Resource {
 				ConflictsWith: []string{"disk_name"},
 			},
 			"dedicated_block_storage_cluster_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Removed:  "Field `dedicated_block_storage_cluster_id` is unavailable and it has been removed since 1.208.0.",
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "Field `dedicated_block_storage_cluster_id` is unavailable and it has been removed since 1.208.0.",
 			},
 		},
 	}
@@ -655,7 +655,6 @@ func resourceAliCloudEcsDiskUpdate(d *schema.ResourceData, meta interface{}) err
 		if err := ecsServiceV2.SetResourceTags(d, "disk"); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 	d.Partial(false)
 	return resourceAliCloudEcsDiskRead(d, meta)
@@ -16,11 +16,11 @@ import (
 
 	"github.com/denverdino/aliyungo/common"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudEcsInstanceSet() *schema.Resource {
@@ -750,7 +750,6 @@ func resourceAlicloudEcsInstanceSetUpdate(d *schema.ResourceData, meta interface
 		if err := ecsService.SetInstanceSetResourceTags(d, "instance", instanceIds); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 
 	if d.HasChange("exclude_instance_filter") {
@@ -4,14 +4,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudEcsInvocation() *schema.Resource {
@@ -20,7 +21,7 @@ func resourceAlicloudEcsInvocation() *schema.Resource {
 		Read:   resourceAlicloudEcsInvocationRead,
 		Delete: resourceAlicloudEcsInvocationDelete,
 		Timeouts: &schema.ResourceTimeout{
-			Create: schema.DefaultTimeout(1 * time.Minute),
+			Create: schema.DefaultTimeout(5 * time.Minute),
 			Delete: schema.DefaultTimeout(1 * time.Minute),
 		},
 		Importer: &schema.ResourceImporter{
@@ -75,10 +76,56 @@ func resourceAlicloudEcsInvocation() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"fail_on_non_zero_exit_code": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"invocation_results": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"invocation_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"output": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"exit_code": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"error_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"error_info": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finished_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -137,11 +184,32 @@ func resourceAlicloudEcsInvocationCreate(d *schema.ResourceData, meta interface{
 	d.SetId(fmt.Sprint(response["InvokeId"]))
 
 	ecsService := EcsService{client}
-	stateConf := BuildStateConf([]string{}, []string{"Scheduled", "Success"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, ecsService.EcsInvocationStateRefreshFunc(d.Id(), []string{"Failed", "PartialFailed", "Stopped"}))
+	stateConf := BuildStateConf([]string{}, []string{"Scheduled", "Success", "Failed", "PartialFailed"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, ecsService.EcsInvocationStateRefreshFunc(d.Id(), []string{"Stopped"}))
 	if _, err := stateConf.WaitForState(); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
+	results, err := ecsService.DescribeEcsInvocationResults(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	var failures []string
+	for _, result := range results {
+		status := fmt.Sprint(result["InvocationStatus"])
+		if status != "Failed" && status != "Timeout" && status != "Error" && status != "Invalid" && status != "Aborted" {
+			continue
+		}
+		// The instances which ran the command but exited with a non-zero code are only reported when fail_on_non_zero_exit_code is true.
+		if fmt.Sprint(result["ErrorCode"]) == "ExitCodeNonzero" && !d.Get("fail_on_non_zero_exit_code").(bool) {
+			continue
+		}
+		failures = append(failures, fmt.Sprintf("%s (status: %s, exit code: %d, error: %s %s)", result["InstanceId"], status, formatInt(result["ExitCode"]), result["ErrorCode"], result["ErrorInfo"]))
+	}
+	if len(failures) > 0 {
+		resourceAlicloudEcsInvocationRead(d, meta)
+		return WrapErrorf(fmt.Errorf("the command failed on the instances: %s", strings.Join(failures, "; ")), IdMsg, d.Id())
+	}
+
 	return resourceAlicloudEcsInvocationRead(d, meta)
 }
 func resourceAlicloudEcsInvocationRead(d *schema.ResourceData, meta interface{}) error {
@@ -182,6 +250,27 @@ func resourceAlicloudEcsInvocationRead(d *schema.ResourceData, meta interface{})
 
 	}
 	d.Set("instance_id", instanceIdItems)
+
+	results, err := ecsService.DescribeEcsInvocationResults(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	invocationResults := make([]map[string]interface{}, 0)
+	for _, result := range results {
+		invocationResults = append(invocationResults, map[string]interface{}{
+			"instance_id":       result["InstanceId"],
+			"invocation_status": result["InvocationStatus"],
+			"output":            result["Output"],
+			"exit_code":         formatInt(result["ExitCode"]),
+			"error_code":        result["ErrorCode"],
+			"error_info":        result["ErrorInfo"],
+			"start_time":        result["StartTime"],
+			"finished_time":     result["FinishedTime"],
+		})
+	}
+	if err := d.Set("invocation_results", invocationResults); err != nil {
+		return WrapError(err)
+	}
 	return nil
 }
 func resourceAlicloudEcsInvocationDelete(d *schema.ResourceData, meta interface{}) error {
@@ -11,12 +11,12 @@ import (
 	"github.com/alibabacloud-go/tea-rpc/client"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestAccAlicloudECSInvocation_basic0(t *testing.T) {
@@ -46,8 +46,15 @@ func TestAccAlicloudECSInvocation_basic0(t *testing.T) {
 				}),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheck(map[string]string{
-						"instance_id.#": "1",
-						"command_id":    CHECKSET,
+						"instance_id.#":                          "1",
+						"command_id":                             CHECKSET,
+						"status":                                 "Success",
+						"invocation_results.#":                   "1",
+						"invocation_results.0.instance_id":       CHECKSET,
+						"invocation_results.0.invocation_status": "Success",
+						"invocation_results.0.exit_code":         "0",
+						"invocation_results.0.output":            CHECKSET,
+						"invocation_results.0.finished_time":     CHECKSET,
 					}),
 				),
 			},
@@ -55,7 +62,7 @@ func TestAccAlicloudECSInvocation_basic0(t *testing.T) {
 				ResourceName:            resourceId,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"windows_password_name"},
+				ImportStateVerifyIgnore: []string{"windows_password_name", "fail_on_non_zero_exit_code"},
 			},
 		},
 	})
@@ -111,7 +118,55 @@ func TestAccAlicloudECSInvocation_basic1(t *testing.T) {
 				ResourceName:            resourceId,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"windows_password_name"},
+				ImportStateVerifyIgnore: []string{"windows_password_name", "fail_on_non_zero_exit_code"},
+			},
+		},
+	})
+}
+
+func TestAccAlicloudECSInvocation_basic2(t *testing.T) {
+	var v map[string]interface{}
+	resourceId := "alicloud_ecs_invocation.default"
+	ra := resourceAttrInit(resourceId, AlicloudECSInvocationMap0)
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
+		return &EcsService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}, "DescribeEcsInvocation")
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc%secsinvocation%d", defaultRegionToTest, rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, AlicloudECSInvocationBasicDependence2)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"instance_id":                []string{"${alicloud_instance.default.id}"},
+					"command_id":                 "${alicloud_ecs_command.default.id}",
+					"fail_on_non_zero_exit_code": "false",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"instance_id.#":                   "1",
+						"command_id":                      CHECKSET,
+						"fail_on_non_zero_exit_code":      "false",
+						"status":                          "Failed",
+						"invocation_results.#":            "1",
+						"invocation_results.0.exit_code":  "3",
+						"invocation_results.0.error_code": "ExitCodeNonzero",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"windows_password_name", "fail_on_non_zero_exit_code"},
 			},
 		},
 	})
@@ -249,7 +304,7 @@ resource "alicloud_ecs_command" "default" {
 }
 
 func TestUnitAlicloudECSInvocation(t *testing.T) {
-	p := Provider().(*schema.Provider).ResourcesMap
+	p := Provider().ResourcesMap
 	dInit, _ := schema.InternalMap(p["alicloud_ecs_invocation"].Schema).Data(nil, nil)
 	dExisted, _ := schema.InternalMap(p["alicloud_ecs_invocation"].Schema).Data(nil, nil)
 	dInit.MarkNewResource()
@@ -473,3 +528,65 @@ func TestUnitAlicloudECSInvocation(t *testing.T) {
 		}
 	}
 }
+
+func AlicloudECSInvocationBasicDependence2(name string) string {
+	return fmt.Sprintf(` 
+variable "name" {
+  default = "%s"
+}
+resource "alicloud_ecs_command" "default" {
+	name              = var.name
+	command_content   = "ZXhpdCAz"
+	description       = "For Terraform Test"
+	type              = "RunShellScript"
+	working_dir       = "/root"
+}
+data "alicloud_zones" "default" {
+  available_disk_category     = "cloud_efficiency"
+  available_resource_creation = "VSwitch"
+}
+data "alicloud_instance_types" "default" {
+  availability_zone = "${data.alicloud_zones.default.zones.0.id}"
+}
+data "alicloud_images" "default" {
+  name_regex  = "^ubuntu"
+  most_recent = true
+  owners      = "system"
+}
+resource "alicloud_vpc" "default" {
+  vpc_name       = "${var.name}"
+  cidr_block = "172.16.0.0/16"
+}
+resource "alicloud_vswitch" "default" {
+  vpc_id            = "${alicloud_vpc.default.id}"
+  cidr_block        = "172.16.0.0/24"
+  zone_id = "${data.alicloud_zones.default.zones.0.id}"
+  vswitch_name              = "${var.name}"
+}
+resource "alicloud_security_group" "default" {
+  name   = "${var.name}"
+  vpc_id = "${alicloud_vpc.default.id}"
+}
+resource "alicloud_security_group_rule" "default" {
+  	type = "ingress"
+  	ip_protocol = "tcp"
+  	nic_type = "intranet"
+  	policy = "accept"
+  	port_range = "22/22"
+  	priority = 1
+  	security_group_id = "${alicloud_security_group.default.id}"
+  	cidr_ip = "172.16.0.0/24"
+}
+
+resource "alicloud_instance" "default" {
+	vswitch_id = "${alicloud_vswitch.default.id}"
+	image_id = "${data.alicloud_images.default.images.0.id}"
+	instance_type = "${data.alicloud_instance_types.default.instance_types.0.id}"
+	system_disk_category = "cloud_efficiency"
+	internet_charge_type = "PayByTraffic"
+	internet_max_bandwidth_out = 5
+	security_groups = ["${alicloud_security_group.default.id}"]
+	instance_name = "${var.name}"
+}
+`, name)
+}
@@ -11,9 +11,9 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAliCloudEcsLaunchTemplate() *schema.Resource {
@@ -189,7 +189,7 @@ func resourceAliCloudEcsLaunchTemplate() *schema.Resource {
 						"primary_ip": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: validation.SingleIP(),
+							ValidateFunc: validation.IsIPAddress,
 						},
 						"security_group_id": {
 							Type:     schema.TypeString,
@@ -351,6 +351,27 @@ func resourceAliCloudEcsLaunchTemplate() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"default_version": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  IntAtLeast(1),
+				ConflictsWith: []string{"update_default_version"},
+			},
+			"update_default_version": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ConflictsWith: []string{"default_version"},
+			},
+			"latest_version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_versions_retained": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: IntBetween(1, 30),
+			},
 			"vpc_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -741,6 +762,8 @@ func resourceAliCloudEcsLaunchTemplateRead(d *schema.ResourceData, meta interfac
 	}
 	d.Set("launch_template_name", object["LaunchTemplateName"])
 	d.Set("name", object["LaunchTemplateName"])
+	d.Set("default_version", formatInt(object["DefaultVersionNumber"]))
+	d.Set("latest_version_number", formatInt(object["LatestVersionNumber"]))
 
 	describeLaunchTemplateVersions, err := ecsService.DescribeLaunchTemplateVersions(d.Id())
 	if err != nil {
@@ -874,7 +897,6 @@ func resourceAliCloudEcsLaunchTemplateUpdate(d *schema.ResourceData, meta interf
 		if err := ecsService.SetResourceTemplateTags(d, "launchtemplate"); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("template_tags")
 	}
 	versions, err := getLaunchTemplateVersions(d.Id(), meta)
 	if err != nil {
@@ -1252,49 +1274,22 @@ func resourceAliCloudEcsLaunchTemplateUpdate(d *schema.ResourceData, meta interf
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("auto_release_time")
-		d.SetPartial("data_disks")
-		d.SetPartial("deployment_set_id")
-		d.SetPartial("description")
-		d.SetPartial("enable_vm_os_config")
-		d.SetPartial("host_name")
-		d.SetPartial("image_id")
-		d.SetPartial("image_owner_alias")
-		d.SetPartial("instance_charge_type")
-		d.SetPartial("instance_name")
-		d.SetPartial("instance_type")
-		d.SetPartial("internet_charge_type")
-		d.SetPartial("internet_max_bandwidth_in")
-		d.SetPartial("internet_max_bandwidth_out")
-		d.SetPartial("io_optimized")
-		d.SetPartial("key_pair_name")
-		d.SetPartial("launch_template_name")
-		d.SetPartial("name")
-		d.SetPartial("network_interfaces")
-		d.SetPartial("network_type")
-		d.SetPartial("password_inherit")
-		d.SetPartial("period")
-		d.SetPartial("private_ip_address")
-		d.SetPartial("ram_role_name")
-		d.SetPartial("resource_group_id")
-		d.SetPartial("security_enhancement_strategy")
-		d.SetPartial("security_group_id")
-		d.SetPartial("security_group_ids")
-		d.SetPartial("spot_duration")
-		d.SetPartial("spot_price_limit")
-		d.SetPartial("spot_strategy")
-		d.SetPartial("system_disk")
-		d.SetPartial("system_disk_category")
-		d.SetPartial("system_disk_description")
-		d.SetPartial("system_disk_name")
-		d.SetPartial("system_disk_size")
-		d.SetPartial("tags")
-		d.SetPartial("user_data")
-		d.SetPartial("userdata")
-		d.SetPartial("vswitch_id")
-		d.SetPartial("version_description")
-		d.SetPartial("vpc_id")
-		d.SetPartial("zone_id")
+	}
+	if update && d.Get("update_default_version").(bool) {
+		if err := ecsService.ModifyEcsLaunchTemplateDefaultVersion(d.Id(), formatInt(response["LaunchTemplateVersionNumber"])); err != nil {
+			return WrapError(err)
+		}
+	} else if d.HasChange("default_version") {
+		if v, ok := d.GetOk("default_version"); ok {
+			if err := ecsService.ModifyEcsLaunchTemplateDefaultVersion(d.Id(), v.(int)); err != nil {
+				return WrapError(err)
+			}
+		}
+	}
+	if v, ok := d.GetOk("max_versions_retained"); ok && (update || d.HasChange("max_versions_retained")) {
+		if err := ecsService.PruneEcsLaunchTemplateVersions(d.Id(), v.(int)); err != nil {
+			return WrapError(err)
+		}
 	}
 	d.Partial(false)
 	return resourceAliCloudEcsLaunchTemplateRead(d, meta)
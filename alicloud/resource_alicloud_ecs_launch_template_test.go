@@ -9,10 +9,10 @@ import (
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func init() {
@@ -922,6 +922,91 @@ func TestAccAliCloudECSLaunchTemplateBasic2(t *testing.T) {
 	})
 }
 
+func TestAccAliCloudECSLaunchTemplateVersionManagement(t *testing.T) {
+	var v map[string]interface{}
+	resourceId := "alicloud_ecs_launch_template.default"
+	ra := resourceAttrInit(resourceId, map[string]string{})
+	serviceFunc := func() interface{} {
+		return &EcsService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testaccLaunchTemplateVersion%v", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceLaunchTemplateConfigDependence)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"launch_template_name":   name,
+					"instance_name":          name,
+					"image_id":               "${data.alicloud_images.default.images.0.id}",
+					"instance_type":          "${data.alicloud_instance_types.default.instance_types.0.id}",
+					"update_default_version": "true",
+					"max_versions_retained":  "2",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"launch_template_name":  name,
+						"default_version":       "1",
+						"latest_version_number": "1",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"instance_name": name + "1",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"instance_name":         name + "1",
+						"default_version":       "2",
+						"latest_version_number": "2",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"instance_name": name + "2",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"instance_name":         name + "2",
+						"default_version":       "3",
+						"latest_version_number": "3",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"update_default_version": REMOVEKEY,
+					"default_version":        "2",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"update_default_version": REMOVEKEY,
+						"default_version":        "2",
+						"latest_version_number":  "3",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceId,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"max_versions_retained", "update_default_version"},
+			},
+		},
+	})
+}
+
 func TestAccAliCloudECSLaunchTemplateMulti(t *testing.T) {
 	var v map[string]interface{}
 	resourceId := "alicloud_ecs_launch_template.default.4"
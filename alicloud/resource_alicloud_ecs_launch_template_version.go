@@ -0,0 +1,543 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	util "github.com/alibabacloud-go/tea-utils/service"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAliCloudEcsLaunchTemplateVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAliCloudEcsLaunchTemplateVersionCreate,
+		Read:   resourceAliCloudEcsLaunchTemplateVersionRead,
+		Update: resourceAliCloudEcsLaunchTemplateVersionUpdate,
+		Delete: resourceAliCloudEcsLaunchTemplateVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"launch_template_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"default_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"image_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"image_owner_alias": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: StringInSlice([]string{"system", "self", "others", "marketplace"}, false),
+			},
+			"instance_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"instance_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"instance_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: StringInSlice([]string{"PostPaid", "PrePaid"}, false),
+			},
+			"host_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"key_pair_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ram_role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"resource_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"security_group_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"vswitch_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"internet_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: StringInSlice([]string{"PayByBandwidth", "PayByTraffic"}, false),
+			},
+			"internet_max_bandwidth_out": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: IntBetween(0, 100),
+			},
+			"spot_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: StringInSlice([]string{"NoSpot", "SpotAsPriceGo", "SpotWithPriceLimit"}, false),
+			},
+			"spot_price_limit": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+				ForceNew: true,
+			},
+			"user_data": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"system_disk": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"category": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+							Computed: true,
+						},
+						"performance_level": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+							Computed: true,
+						},
+						"delete_with_instance": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"data_disks": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"category": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"snapshot_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"performance_level": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"encrypted": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+						},
+						"delete_with_instance": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  true,
+						},
+					},
+				},
+			},
+			"tags": tagsSchemaForceNew(),
+			"version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"launch_template_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAliCloudEcsLaunchTemplateVersionCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+	var response map[string]interface{}
+	action := "CreateLaunchTemplateVersion"
+	conn, err := client.NewEcsClient()
+	if err != nil {
+		return WrapError(err)
+	}
+	request := map[string]interface{}{
+		"RegionId":         client.RegionId,
+		"LaunchTemplateId": d.Get("launch_template_id"),
+	}
+	for key, param := range map[string]string{
+		"version_description":  "VersionDescription",
+		"description":          "Description",
+		"image_id":             "ImageId",
+		"image_owner_alias":    "ImageOwnerAlias",
+		"instance_type":        "InstanceType",
+		"instance_name":        "InstanceName",
+		"instance_charge_type": "InstanceChargeType",
+		"host_name":            "HostName",
+		"key_pair_name":        "KeyPairName",
+		"ram_role_name":        "RamRoleName",
+		"resource_group_id":    "ResourceGroupId",
+		"security_group_id":    "SecurityGroupId",
+		"vpc_id":               "VpcId",
+		"vswitch_id":           "VSwitchId",
+		"zone_id":              "ZoneId",
+		"internet_charge_type": "InternetChargeType",
+		"spot_strategy":        "SpotStrategy",
+		"user_data":            "UserData",
+	} {
+		if v, ok := d.GetOk(key); ok {
+			request[param] = v
+		}
+	}
+	if v, ok := d.GetOkExists("internet_max_bandwidth_out"); ok {
+		request["InternetMaxBandwidthOut"] = v
+	}
+	if v, ok := d.GetOk("spot_price_limit"); ok {
+		request["SpotPriceLimit"] = v
+	}
+	if v, ok := d.GetOk("security_group_ids"); ok {
+		for i, securityGroupId := range v.([]interface{}) {
+			request[fmt.Sprintf("SecurityGroupIds.%d", i+1)] = securityGroupId
+		}
+	}
+	if v, ok := d.GetOk("system_disk"); ok {
+		for _, systemDisk := range v.([]interface{}) {
+			systemDiskArg, ok := systemDisk.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if category, ok := systemDiskArg["category"].(string); ok && category != "" {
+				request["SystemDisk.Category"] = category
+			}
+			if size, ok := systemDiskArg["size"].(int); ok && size > 0 {
+				request["SystemDisk.Size"] = size
+			}
+			if performanceLevel, ok := systemDiskArg["performance_level"].(string); ok && performanceLevel != "" {
+				request["SystemDisk.PerformanceLevel"] = performanceLevel
+			}
+			request["SystemDisk.DeleteWithInstance"] = systemDiskArg["delete_with_instance"]
+		}
+	}
+	if v, ok := d.GetOk("data_disks"); ok {
+		for i, dataDisk := range v.([]interface{}) {
+			dataDiskArg, ok := dataDisk.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if category, ok := dataDiskArg["category"].(string); ok && category != "" {
+				request[fmt.Sprintf("DataDisk.%d.Category", i+1)] = category
+			}
+			if size, ok := dataDiskArg["size"].(int); ok && size > 0 {
+				request[fmt.Sprintf("DataDisk.%d.Size", i+1)] = size
+			}
+			if snapshotId, ok := dataDiskArg["snapshot_id"].(string); ok && snapshotId != "" {
+				request[fmt.Sprintf("DataDisk.%d.SnapshotId", i+1)] = snapshotId
+			}
+			if performanceLevel, ok := dataDiskArg["performance_level"].(string); ok && performanceLevel != "" {
+				request[fmt.Sprintf("DataDisk.%d.PerformanceLevel", i+1)] = performanceLevel
+			}
+			request[fmt.Sprintf("DataDisk.%d.Encrypted", i+1)] = fmt.Sprint(dataDiskArg["encrypted"])
+			request[fmt.Sprintf("DataDisk.%d.DeleteWithInstance", i+1)] = dataDiskArg["delete_with_instance"]
+		}
+	}
+	if v, ok := d.GetOk("tags"); ok {
+		count := 1
+		for key, value := range v.(map[string]interface{}) {
+			request[fmt.Sprintf("Tag.%d.Key", count)] = key
+			request[fmt.Sprintf("Tag.%d.Value", count)] = value
+			count++
+		}
+	}
+
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+		if err != nil {
+			if NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alicloud_ecs_launch_template_version", action, AlibabaCloudSdkGoERROR)
+	}
+
+	d.SetId(fmt.Sprintf("%v:%d", request["LaunchTemplateId"], formatInt(response["LaunchTemplateVersionNumber"])))
+
+	if d.Get("default_version").(bool) {
+		if err := ecsService.ModifyEcsLaunchTemplateDefaultVersion(fmt.Sprint(request["LaunchTemplateId"]), formatInt(response["LaunchTemplateVersionNumber"])); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	return resourceAliCloudEcsLaunchTemplateVersionRead(d, meta)
+}
+
+func resourceAliCloudEcsLaunchTemplateVersionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+	object, err := ecsService.DescribeEcsLaunchTemplateVersion(d.Id())
+	if err != nil {
+		if !d.IsNewResource() && NotFoundError(err) {
+			log.Printf("[DEBUG] Resource alicloud_ecs_launch_template_version ecsService.DescribeEcsLaunchTemplateVersion Failed!!! %s", err)
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	d.Set("launch_template_id", object["LaunchTemplateId"])
+	d.Set("launch_template_name", object["LaunchTemplateName"])
+	d.Set("version_number", formatInt(object["VersionNumber"]))
+	d.Set("version_description", object["VersionDescription"])
+	d.Set("default_version", formatBool(object["DefaultVersion"]))
+
+	data, _ := object["LaunchTemplateData"].(map[string]interface{})
+	d.Set("description", data["Description"])
+	d.Set("image_id", data["ImageId"])
+	d.Set("image_owner_alias", data["ImageOwnerAlias"])
+	d.Set("instance_type", data["InstanceType"])
+	d.Set("instance_name", data["InstanceName"])
+	d.Set("instance_charge_type", data["InstanceChargeType"])
+	d.Set("host_name", data["HostName"])
+	d.Set("key_pair_name", data["KeyPairName"])
+	d.Set("ram_role_name", data["RamRoleName"])
+	d.Set("resource_group_id", data["ResourceGroupId"])
+	d.Set("security_group_id", data["SecurityGroupId"])
+	if securityGroupIds, ok := data["SecurityGroupIds"].(map[string]interface{}); ok {
+		d.Set("security_group_ids", securityGroupIds["SecurityGroupId"])
+	}
+	d.Set("vpc_id", data["VpcId"])
+	d.Set("vswitch_id", data["VSwitchId"])
+	d.Set("zone_id", data["ZoneId"])
+	d.Set("internet_charge_type", data["InternetChargeType"])
+	d.Set("internet_max_bandwidth_out", formatInt(data["InternetMaxBandwidthOut"]))
+	d.Set("spot_strategy", data["SpotStrategy"])
+	if v, ok := data["SpotPriceLimit"]; ok && v != nil {
+		spotPriceLimit, _ := strconv.ParseFloat(fmt.Sprint(v), 64)
+		d.Set("spot_price_limit", spotPriceLimit)
+	}
+	d.Set("user_data", data["UserData"])
+
+	systemDisk := []map[string]interface{}{
+		{
+			"category":             data["SystemDisk.Category"],
+			"size":                 formatInt(data["SystemDisk.Size"]),
+			"performance_level":    data["SystemDisk.PerformanceLevel"],
+			"delete_with_instance": formatBool(data["SystemDisk.DeleteWithInstance"]),
+		},
+	}
+	if err := d.Set("system_disk", systemDisk); err != nil {
+		return WrapError(err)
+	}
+
+	dataDisks := make([]map[string]interface{}, 0)
+	if dataDisksObject, ok := data["DataDisks"].(map[string]interface{}); ok {
+		if dataDiskList, ok := dataDisksObject["DataDisk"].([]interface{}); ok {
+			for _, v := range dataDiskList {
+				if m1, ok := v.(map[string]interface{}); ok {
+					dataDisks = append(dataDisks, map[string]interface{}{
+						"category":             m1["Category"],
+						"size":                 formatInt(m1["Size"]),
+						"snapshot_id":          m1["SnapshotId"],
+						"performance_level":    m1["PerformanceLevel"],
+						"encrypted":            formatBool(m1["Encrypted"]),
+						"delete_with_instance": formatBool(m1["DeleteWithInstance"]),
+					})
+				}
+			}
+		}
+	}
+	if err := d.Set("data_disks", dataDisks); err != nil {
+		return WrapError(err)
+	}
+
+	if tags, ok := data["Tags"].(map[string]interface{}); ok {
+		d.Set("tags", tagsToMap(tags["InstanceTag"]))
+	}
+	return nil
+}
+
+func resourceAliCloudEcsLaunchTemplateVersionUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	ecsService := EcsService{client}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	if d.HasChange("default_version") {
+		if !d.Get("default_version").(bool) {
+			return WrapError(fmt.Errorf("the default version of the launch template %s can not be unset, please set default_version on another version instead", parts[0]))
+		}
+		version, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return WrapError(err)
+		}
+		if err := ecsService.ModifyEcsLaunchTemplateDefaultVersion(parts[0], version); err != nil {
+			return WrapError(err)
+		}
+	}
+	return resourceAliCloudEcsLaunchTemplateVersionRead(d, meta)
+}
+
+func resourceAliCloudEcsLaunchTemplateVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	var response map[string]interface{}
+	action := "DeleteLaunchTemplateVersion"
+	conn, err := client.NewEcsClient()
+	if err != nil {
+		return WrapError(err)
+	}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	ecsService := EcsService{client}
+	object, err := ecsService.DescribeEcsLaunchTemplateVersion(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapError(err)
+	}
+	// The default version can not be deleted, so the latest one of the other versions becomes the default version.
+	if formatBool(object["DefaultVersion"]) {
+		versions, err := ecsService.DescribeLaunchTemplateVersions(parts[0])
+		if err != nil {
+			return WrapError(err)
+		}
+		latestVersion := 0
+		for _, version := range versions {
+			if number := formatInt(version.(map[string]interface{})["VersionNumber"]); fmt.Sprint(number) != parts[1] && number > latestVersion {
+				latestVersion = number
+			}
+		}
+		if latestVersion == 0 {
+			log.Printf("[WARN] The version %s is the only version of the launch template %s and can not be deleted. Terraform will remove this resource from the state file, however it will remain until the launch template is deleted.", parts[1], parts[0])
+			return nil
+		}
+		if err := ecsService.ModifyEcsLaunchTemplateDefaultVersion(parts[0], latestVersion); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	request := map[string]interface{}{
+		"RegionId":         client.RegionId,
+		"LaunchTemplateId": parts[0],
+		"DeleteVersion.1":  parts[1],
+	}
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+		if err != nil {
+			if NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	if err != nil {
+		if IsExpectedErrors(err, []string{"InvalidLaunchTemplate.NotFound", "InvalidLaunchTemplateVersion.NotFound"}) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package alicloud
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAliCloudEcsLaunchTemplateVersion_basic0(t *testing.T) {
+	var v map[string]interface{}
+	resourceId := "alicloud_ecs_launch_template_version.default"
+	ra := resourceAttrInit(resourceId, AliCloudEcsLaunchTemplateVersionMap0)
+	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
+		return &EcsService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	}, "DescribeEcsLaunchTemplateVersion")
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(10000, 99999)
+	name := fmt.Sprintf("tf-testacc%secslaunchtemplateversion%d", defaultRegionToTest, rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, AliCloudEcsLaunchTemplateVersionBasicDependence0)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"launch_template_id":  "${alicloud_ecs_launch_template.default.id}",
+					"version_description": name,
+					"image_id":            "${data.alicloud_images.default.images.0.id}",
+					"instance_type":       "${data.alicloud_instance_types.default.instance_types.0.id}",
+					"instance_name":       name,
+					"security_group_id":   "${alicloud_security_group.default.id}",
+					"vswitch_id":          "${alicloud_vswitch.default.id}",
+					"system_disk": []map[string]interface{}{
+						{
+							"category": "cloud_efficiency",
+							"size":     "40",
+						},
+					},
+					"data_disks": []map[string]interface{}{
+						{
+							"category": "cloud_efficiency",
+							"size":     "20",
+						},
+					},
+					"tags": map[string]string{
+						"Created": "TF",
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"launch_template_id":     CHECKSET,
+						"version_description":    name,
+						"instance_name":          name,
+						"version_number":         "2",
+						"default_version":        "false",
+						"system_disk.#":          "1",
+						"system_disk.0.category": "cloud_efficiency",
+						"system_disk.0.size":     "40",
+						"data_disks.#":           "1",
+						"tags.%":                 "1",
+						"tags.Created":           "TF",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"default_version": "true",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"default_version": "true",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+var AliCloudEcsLaunchTemplateVersionMap0 = map[string]string{
+	"launch_template_name": CHECKSET,
+	"vpc_id":               CHECKSET,
+	"zone_id":              CHECKSET,
+}
+
+func AliCloudEcsLaunchTemplateVersionBasicDependence0(name string) string {
+	return fmt.Sprintf(`
+variable "name" {
+  default = "%s"
+}
+
+data "alicloud_zones" "default" {
+  available_disk_category     = "cloud_efficiency"
+  available_resource_creation = "VSwitch"
+}
+
+data "alicloud_instance_types" "default" {
+  availability_zone = data.alicloud_zones.default.zones.0.id
+}
+
+data "alicloud_images" "default" {
+  name_regex  = "^ubuntu"
+  most_recent = true
+  owners      = "system"
+}
+
+resource "alicloud_vpc" "default" {
+  vpc_name   = var.name
+  cidr_block = "172.16.0.0/16"
+}
+
+resource "alicloud_vswitch" "default" {
+  vpc_id       = alicloud_vpc.default.id
+  cidr_block   = "172.16.0.0/24"
+  zone_id      = data.alicloud_zones.default.zones.0.id
+  vswitch_name = var.name
+}
+
+resource "alicloud_security_group" "default" {
+  name   = var.name
+  vpc_id = alicloud_vpc.default.id
+}
+
+resource "alicloud_ecs_launch_template" "default" {
+  launch_template_name = var.name
+  image_id             = data.alicloud_images.default.images.0.id
+  instance_type        = data.alicloud_instance_types.default.instance_types.0.id
+  security_group_id    = alicloud_security_group.default.id
+  vswitch_id           = alicloud_vswitch.default.id
+}
+`, name)
+}
@@ -8,9 +8,9 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAliCloudEcsNetworkInterface() *schema.Resource {
@@ -369,7 +369,6 @@ func resourceAliCloudEcsNetworkInterfaceUpdate(d *schema.ResourceData, meta inte
 		if err := ecsService.SetResourceTags(d, "eni"); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 	update := false
 	updateSecurityGroup := false
@@ -421,12 +420,6 @@ func resourceAliCloudEcsNetworkInterfaceUpdate(d *schema.ResourceData, meta inte
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("description")
-		d.SetPartial("name")
-		d.SetPartial("network_interface_name")
-		d.SetPartial("queue_number")
-		d.SetPartial("security_groups")
-		d.SetPartial("security_group_ids")
 		if updateSecurityGroup {
 			time.Sleep(500 * time.Millisecond)
 		}
@@ -462,7 +455,6 @@ func resourceAliCloudEcsNetworkInterfaceUpdate(d *schema.ResourceData, meta inte
 			if err != nil {
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 			}
-			d.SetPartial("private_ip_addresses")
 		}
 		if added.Len() > 0 {
 			assignprivateipaddressesrequest := map[string]interface{}{
@@ -487,7 +479,6 @@ func resourceAliCloudEcsNetworkInterfaceUpdate(d *schema.ResourceData, meta inte
 			if err != nil {
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 			}
-			d.SetPartial("private_ip_addresses")
 		}
 		if err := ecsService.WaitForPrivateIpsListChanged(d.Id(), expandStringList(newPrivateIpAddressesSet.List())); err != nil {
 			return WrapError(err)
@@ -523,7 +514,6 @@ func resourceAliCloudEcsNetworkInterfaceUpdate(d *schema.ResourceData, meta inte
 			if err != nil {
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 			}
-			d.SetPartial("private_ips")
 		}
 		if added.Len() > 0 {
 			assignprivateipaddressesrequest := map[string]interface{}{
@@ -548,7 +538,6 @@ func resourceAliCloudEcsNetworkInterfaceUpdate(d *schema.ResourceData, meta inte
 			if err != nil {
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 			}
-			d.SetPartial("private_ips")
 		}
 		if err := ecsService.WaitForPrivateIpsListChanged(d.Id(), expandStringList(newPrivateIpsSet.List())); err != nil {
 			return WrapError(err)
@@ -615,7 +604,6 @@ func resourceAliCloudEcsNetworkInterfaceUpdate(d *schema.ResourceData, meta inte
 			if err != nil {
 				return WrapError(err)
 			}
-			d.SetPartial("private_ips_count")
 		}
 	}
 	if d.HasChange("secondary_private_ip_address_count") {
@@ -680,7 +668,6 @@ func resourceAliCloudEcsNetworkInterfaceUpdate(d *schema.ResourceData, meta inte
 			if err != nil {
 				return WrapError(err)
 			}
-			d.SetPartial("secondary_private_ip_address_count")
 		}
 	}
 	if d.HasChange("ipv6_address_count") {
@@ -745,8 +732,6 @@ func resourceAliCloudEcsNetworkInterfaceUpdate(d *schema.ResourceData, meta inte
 			if err != nil {
 				return WrapError(err)
 			}
-			d.SetPartial("ipv6_address_count")
-			d.SetPartial("ipv6_addresses")
 		}
 	}
 
@@ -813,8 +798,6 @@ func resourceAliCloudEcsNetworkInterfaceUpdate(d *schema.ResourceData, meta inte
 		if err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("ipv6_address_count")
-		d.SetPartial("ipv6_addresses")
 	}
 
 	if d.HasChange("ipv4_prefix_count") {
@@ -879,8 +862,6 @@ func resourceAliCloudEcsNetworkInterfaceUpdate(d *schema.ResourceData, meta inte
 			if err != nil {
 				return WrapError(err)
 			}
-			d.SetPartial("ipv4_prefix_count")
-			d.SetPartial("ipv4_prefixes")
 		}
 	}
 
@@ -947,8 +928,6 @@ func resourceAliCloudEcsNetworkInterfaceUpdate(d *schema.ResourceData, meta inte
 		if err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("ipv4_prefix_count")
-		d.SetPartial("ipv4_prefixes")
 	}
 	return resourceAliCloudEcsNetworkInterfaceRead(d, meta)
 }
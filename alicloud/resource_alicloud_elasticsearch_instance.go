@@ -9,12 +9,12 @@ import (
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/denverdino/aliyungo/common"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudElasticsearch() *schema.Resource {
@@ -545,7 +545,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 			return WrapError(err)
 		}
 
-		d.SetPartial("description")
 	}
 
 	if d.HasChange("private_whitelist") {
@@ -557,7 +556,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 			return WrapError(err)
 		}
 
-		d.SetPartial("private_whitelist")
 	}
 
 	if d.HasChange("enable_public") {
@@ -569,7 +567,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 			return WrapError(err)
 		}
 
-		d.SetPartial("enable_public")
 	}
 
 	if d.HasChange("public_whitelist") {
@@ -581,7 +578,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 			return WrapError(err)
 		}
 
-		d.SetPartial("public_whitelist")
 	}
 
 	if d.HasChange("enable_kibana_public_network") {
@@ -593,7 +589,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 			return WrapError(err)
 		}
 
-		d.SetPartial("enable_kibana_public_network")
 	}
 
 	if d.HasChange("kibana_whitelist") {
@@ -605,7 +600,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 			return WrapError(err)
 		}
 
-		d.SetPartial("kibana_whitelist")
 	}
 
 	if d.HasChange("enable_kibana_private_network") && archType != "public" {
@@ -617,7 +611,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 			return WrapError(err)
 		}
 
-		d.SetPartial("enable_kibana_private_network")
 	}
 
 	// modify pvl kibana private security group, update security group only
@@ -630,7 +623,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 		if err := elasticsearchService.updateKibanaPrivatePvlNetwork(d, content, meta); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("kibana_private_security_group_id")
 	}
 
 	// open pvl kibana private
@@ -652,7 +644,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 		if err := elasticsearchService.enableKibanaPrivatePvlNetwork(d, content, meta); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("enable_kibana_private_network")
 	}
 	//close kibana pvl private network
 	if d.HasChange("enable_kibana_private_network") && archType == "public" && d.Get("enable_kibana_private_network").(bool) == false {
@@ -660,7 +651,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 		if err := elasticsearchService.disableKibanaPrivatePvlNetwork(d, content, meta); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("enable_kibana_private_network")
 	}
 
 	if d.HasChange("kibana_private_whitelist") {
@@ -672,7 +662,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 			return WrapError(err)
 		}
 
-		d.SetPartial("kibana_private_whitelist")
 	}
 
 	if d.HasChange("tags") {
@@ -680,7 +669,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 			return WrapError(err)
 		}
 
-		d.SetPartial("tags")
 	}
 
 	if d.HasChange("protocol") {
@@ -703,7 +691,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 			}
 		}
 
-		d.SetPartial("protocol")
 	}
 
 	if d.HasChange("setting_config") {
@@ -743,7 +730,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("setting_config")
 	}
 
 	if d.Get("instance_charge_type").(string) == string(PrePaid) && (d.HasChange("renew_status") || d.HasChange("auto_renew_duration") || d.HasChange("renewal_duration_unit")) {
@@ -751,9 +737,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 			return WrapError(err)
 		}
 
-		d.SetPartial("renew_status")
-		d.SetPartial("auto_renew_duration")
-		d.SetPartial("renewal_duration_unit")
 	}
 
 	if d.IsNewResource() {
@@ -766,14 +749,11 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 			return WrapError(err)
 		}
 
-		d.SetPartial("instance_charge_type")
-		d.SetPartial("period")
 	} else if d.Get("instance_charge_type").(string) == string(PrePaid) && d.HasChange("period") {
 		if err := renewInstance(d, meta); err != nil {
 			return WrapError(err)
 		}
 
-		d.SetPartial("period")
 	}
 
 	if d.HasChange("data_node_amount") {
@@ -786,7 +766,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 			return WrapError(err)
 		}
 
-		d.SetPartial("data_node_amount")
 	}
 
 	if d.HasChange("data_node_spec") || d.HasChange("data_node_disk_size") || d.HasChange("data_node_disk_performance_level") {
@@ -799,11 +778,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 			return WrapError(err)
 		}
 
-		d.SetPartial("data_node_spec")
-		d.SetPartial("data_node_disk_size")
-		d.SetPartial("data_node_disk_type")
-		d.SetPartial("data_node_disk_encrypted")
-		d.SetPartial("data_node_disk_performance_level")
 	}
 
 	if d.HasChange("master_node_spec") || d.HasChange("master_node_disk_type") {
@@ -816,8 +790,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 			return WrapError(err)
 		}
 
-		d.SetPartial("master_node_spec")
-		d.SetPartial("master_node_disk_type")
 	}
 
 	if d.HasChange("kibana_node_spec") {
@@ -830,7 +802,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 			return WrapError(err)
 		}
 
-		d.SetPartial("kibana_node_spec")
 	}
 
 	if d.HasChange("client_node_spec") || d.HasChange("client_node_amount") {
@@ -843,8 +814,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 			return WrapError(err)
 		}
 
-		d.SetPartial("client_node_spec")
-		d.SetPartial("client_node_amount")
 	}
 
 	if d.HasChanges("warm_node_spec", "warm_node_amount", "warm_node_disk_size") {
@@ -852,9 +821,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 			return WrapError(err)
 		}
 
-		d.SetPartial("warm_node_spec")
-		d.SetPartial("warm_node_amount")
-		d.SetPartial("warm_node_disk_size")
 	}
 
 	if d.HasChange("password") || d.HasChange("kms_encrypted_password") {
@@ -867,7 +833,6 @@ func resourceAlicloudElasticsearchUpdate(d *schema.ResourceData, meta interface{
 			return WrapError(err)
 		}
 
-		d.SetPartial("password")
 	}
 
 	d.Partial(false)
@@ -8,13 +8,13 @@ import (
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudEmrCluster() *schema.Resource {
@@ -769,7 +769,6 @@ func resourceAlicloudEmrClusterUpdate(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("name")
 	}
 
 	if d.HasChange("host_group") {
@@ -1014,7 +1013,6 @@ func resourceAlicloudEmrClusterUpdate(d *schema.ResourceData, meta interface{})
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 			}
 		}
-		d.SetPartial("host_group")
 	}
 
 	if d.HasChange("modify_cluster_service_config") {
@@ -1064,7 +1062,6 @@ func resourceAlicloudEmrClusterUpdate(d *schema.ResourceData, meta interface{})
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 			}
 		}
-		d.SetPartial("modify_cluster_service_config")
 	}
 
 	d.Partial(false)
@@ -7,8 +7,8 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudEssAttachment() *schema.Resource {
@@ -204,7 +204,6 @@ func resourceAliyunEssAttachmentUpdate(d *schema.ResourceData, meta interface{})
 			}
 		}
 
-		d.SetPartial("instance_ids")
 	}
 
 	d.Partial(false)
@@ -14,8 +14,8 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudEssScalingConfiguration() *schema.Resource {
@@ -40,6 +40,7 @@ func resourceAlicloudEssScalingConfiguration() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"instance_refresh": essInstanceRefreshSchema(false),
 			"enable": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -828,7 +829,6 @@ func resourceAliyunEssScalingConfigurationUpdate(d *schema.ResourceData, meta in
 				}
 			}
 		}
-		d.SetPartial("active")
 	}
 
 	if err := enableEssScalingConfiguration(d, meta); err != nil {
@@ -838,6 +838,18 @@ func resourceAliyunEssScalingConfigurationUpdate(d *schema.ResourceData, meta in
 		return WrapError(err)
 	}
 
+	// The instances of the scaling group are refreshed after the active scaling configuration is changed.
+	if v, ok := d.GetOk("instance_refresh"); ok && !d.IsNewResource() && d.Get("active").(bool) &&
+		(d.HasChange("active") || d.HasChangesExcept("enable", "force_delete", "substitute", "scaling_configuration_name", "instance_ids", "is_outdated", "tags", "instance_refresh")) {
+		instanceRefresh, _ := v.([]interface{})[0].(map[string]interface{})
+		desiredConfiguration := map[string]interface{}{
+			"ScalingConfigurationId": d.Id(),
+		}
+		if err := essService.RefreshEssInstances(d.Get("scaling_group_id").(string), desiredConfiguration, instanceRefresh, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapError(err)
+		}
+	}
+
 	d.Partial(false)
 
 	return resourceAliyunEssScalingConfigurationRead(d, meta)
@@ -1263,7 +1275,6 @@ func enableEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 					return WrapError(err)
 				}
 
-				d.SetPartial("scaling_configuration_id")
 			}
 		} else {
 			if group.LifecycleState == string(Active) {
@@ -1282,7 +1293,6 @@ func enableEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 				}
 			}
 		}
-		d.SetPartial("enable")
 	}
 
 	return nil
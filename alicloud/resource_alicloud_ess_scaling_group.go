@@ -12,8 +12,8 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudEssScalingGroup() *schema.Resource {
@@ -25,6 +25,9 @@ func resourceAlicloudEssScalingGroup() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(20 * time.Minute),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"min_size": {
@@ -206,7 +209,8 @@ func resourceAlicloudEssScalingGroup() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":             tagsSchema(),
+			"instance_refresh": essInstanceRefreshSchema(true),
 			"group_type": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -638,10 +642,38 @@ func resourceAliyunEssScalingGroupUpdate(d *schema.ResourceData, meta interface{
 		}
 	}
 
+	if v, ok := d.GetOk("instance_refresh"); ok && !d.IsNewResource() && essInstanceRefreshTriggered(d) {
+		instanceRefresh, _ := v.([]interface{})[0].(map[string]interface{})
+		desiredConfiguration := make(map[string]interface{})
+		if launchTemplateId := d.Get("launch_template_id").(string); launchTemplateId != "" {
+			desiredConfiguration["LaunchTemplateId"] = launchTemplateId
+			desiredConfiguration["LaunchTemplateVersion"] = d.Get("launch_template_version")
+		}
+		if err := essService.RefreshEssInstances(d.Id(), desiredConfiguration, instanceRefresh, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapError(err)
+		}
+	}
+
 	d.Partial(false)
 	return resourceAliyunEssScalingGroupRead(d, meta)
 }
 
+// essInstanceRefreshTriggered returns whether the instances of the scaling group should be refreshed, which is triggered
+// by the changes of the launch template and the attributes in the instance_refresh triggers.
+func essInstanceRefreshTriggered(d *schema.ResourceData) bool {
+	if d.HasChange("launch_template_id") || d.HasChange("launch_template_version") {
+		return true
+	}
+	if v, ok := d.GetOk("instance_refresh.0.triggers"); ok {
+		for _, trigger := range v.(*schema.Set).List() {
+			if d.HasChange(trigger.(string)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func resourceAliyunEssScalingGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AliyunClient)
 	essService := EssService{client}
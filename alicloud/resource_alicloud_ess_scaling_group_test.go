@@ -11,9 +11,9 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func init() {
@@ -525,6 +525,88 @@ func TestAccAliClouddEssScalingGroup_withLaunchTemplateId(t *testing.T) {
 
 }
 
+func TestAccAliCloudEssScalingGroup_instanceRefresh(t *testing.T) {
+	rand := acctest.RandIntRange(10000, 999999)
+	var v ess.ScalingGroup
+	resourceId := "alicloud_ess_scaling_group.default"
+
+	basicMap := map[string]string{
+		"min_size":                "0",
+		"max_size":                "4",
+		"scaling_group_name":      fmt.Sprintf("tf-testAccEssScalingGroup-%d", rand),
+		"vswitch_ids.#":           "1",
+		"launch_template_version": "Default",
+		"instance_refresh.#":      "1",
+	}
+
+	ra := resourceAttrInit(resourceId, basicMap)
+	rc := resourceCheckInit(resourceId, &v, func() interface{} {
+		return &EssService{testAccProvider.Meta().(*connectivity.AliyunClient)}
+	})
+	rac := resourceAttrCheckInit(rc, ra)
+
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	name := fmt.Sprintf("tf-testAccEssScalingGroup-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceEssScalingGroupTemplate)
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  testAccCheckEssScalingGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"min_size":                "0",
+					"max_size":                "4",
+					"scaling_group_name":      "${var.name}",
+					"vswitch_ids":             []string{"${alicloud_vswitch.default.id}"},
+					"launch_template_id":      "${alicloud_ecs_launch_template.default3.id}",
+					"launch_template_version": "Default",
+					"instance_refresh": []map[string]interface{}{
+						{
+							"min_healthy_percentage": "50",
+							"max_healthy_percentage": "150",
+							"checkpoints":            []string{"50"},
+							"checkpoint_pause_time":  "1",
+							"triggers":               []string{"max_size"},
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(nil),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"min_size":                "0",
+					"max_size":                "5",
+					"scaling_group_name":      "${var.name}",
+					"vswitch_ids":             []string{"${alicloud_vswitch.default.id}"},
+					"launch_template_id":      "${alicloud_ecs_launch_template.default3.id}",
+					"launch_template_version": "Latest",
+					"instance_refresh": []map[string]interface{}{
+						{
+							"min_healthy_percentage": "50",
+							"max_healthy_percentage": "150",
+							"checkpoints":            []string{"50"},
+							"checkpoint_pause_time":  "1",
+							"triggers":               []string{"max_size"},
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"max_size":                "5",
+						"launch_template_version": "Latest",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAliClouddEssScalingGroup_withLaunchTemplateOverride(t *testing.T) {
 	rand := acctest.RandIntRange(10000, 999999)
 	var v ess.ScalingGroup
@@ -7,14 +7,14 @@ import (
 	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudEssScalingGroupVserverGroups() *schema.Resource {
@@ -134,13 +134,11 @@ func resourceAliyunEssVserverGroupsUpdate(d *schema.ResourceData, meta interface
 	if err != nil {
 		return WrapError(err)
 	}
-	d.SetPartial("vserver_groups")
 
 	err = attachVserverGroups(d, client, attachMap, force)
 	if err != nil {
 		return WrapError(err)
 	}
-	d.SetPartial("vserver_groups")
 	d.Partial(false)
 	return resourceAliyunEssVserverGroupsRead(d, meta)
 }
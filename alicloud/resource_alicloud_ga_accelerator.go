@@ -6,8 +6,8 @@ import (
 	"time"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudGaAccelerator() *schema.Resource {
@@ -229,7 +229,6 @@ func resourceAliCloudGaAcceleratorUpdate(d *schema.ResourceData, meta interface{
 		if err := gaService.SetResourceTags(d, "accelerator"); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 
 	update := false
@@ -275,8 +274,6 @@ func resourceAliCloudGaAcceleratorUpdate(d *schema.ResourceData, meta interface{
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
 
-		d.SetPartial("renewal_status")
-		d.SetPartial("auto_renew_duration")
 	}
 
 	update = false
@@ -326,9 +323,6 @@ func resourceAliCloudGaAcceleratorUpdate(d *schema.ResourceData, meta interface{
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("spec")
-		d.SetPartial("accelerator_name")
-		d.SetPartial("description")
 	}
 
 	update = false
@@ -372,7 +366,6 @@ func resourceAliCloudGaAcceleratorUpdate(d *schema.ResourceData, meta interface{
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("cross_border_status")
 	}
 
 	update = false
@@ -416,7 +409,6 @@ func resourceAliCloudGaAcceleratorUpdate(d *schema.ResourceData, meta interface{
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("cross_border_mode")
 	}
 
 	update = false
@@ -456,7 +448,6 @@ func resourceAliCloudGaAcceleratorUpdate(d *schema.ResourceData, meta interface{
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
 
-		d.SetPartial("resource_group_id")
 	}
 
 	d.Partial(false)
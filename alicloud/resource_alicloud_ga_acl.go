@@ -7,8 +7,8 @@ import (
 	"time"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudGaAcl() *schema.Resource {
@@ -189,7 +189,6 @@ func resourceAliCloudGaAclUpdate(d *schema.ResourceData, meta interface{}) error
 		if err := gaService.SetResourceTags(d, "acl"); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 
 	update := false
@@ -232,7 +231,6 @@ func resourceAliCloudGaAclUpdate(d *schema.ResourceData, meta interface{}) error
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
 
-		d.SetPartial("acl_name")
 	}
 
 	if !d.IsNewResource() && d.HasChange("acl_entries") {
@@ -327,7 +325,6 @@ func resourceAliCloudGaAclUpdate(d *schema.ResourceData, meta interface{}) error
 			}
 		}
 
-		d.SetPartial("acl_entries")
 	}
 
 	update = false
@@ -366,7 +363,6 @@ func resourceAliCloudGaAclUpdate(d *schema.ResourceData, meta interface{}) error
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
 
-		d.SetPartial("resource_group_id")
 	}
 
 	d.Partial(false)
@@ -7,9 +7,9 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlicloudHbrEcsBackupClient() *schema.Resource {
@@ -268,7 +268,6 @@ func resourceAlicloudHbrEcsBackupClientUpdate(d *schema.ResourceData, meta inter
 			}
 		}
 	}
-	d.SetPartial("status")
 
 	return resourceAlicloudHbrEcsBackupClientRead(d, meta)
 }
@@ -8,9 +8,9 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlicloudHbrEcsBackupPlan() *schema.Resource {
@@ -308,16 +308,6 @@ func resourceAlicloudHbrEcsBackupPlanUpdate(d *schema.ResourceData, meta interfa
 		if fmt.Sprint(response["Success"]) == "false" {
 			return WrapError(fmt.Errorf("%s failed, response: %v", action, response))
 		}
-		d.SetPartial("vault_id")
-		d.SetPartial("detail")
-		d.SetPartial("ecs_backup_plan_name")
-		d.SetPartial("exclude")
-		d.SetPartial("include")
-		d.SetPartial("options")
-		d.SetPartial("path")
-		d.SetPartial("retention")
-		d.SetPartial("schedule")
-		d.SetPartial("speed_limit")
 	}
 	if d.HasChange("disabled") {
 		object, err := hbrService.DescribeHbrEcsBackupPlan(d.Id())
@@ -358,7 +348,6 @@ func resourceAlicloudHbrEcsBackupPlanUpdate(d *schema.ResourceData, meta interfa
 			}
 		}
 	}
-	d.SetPartial("disabled")
 
 	d.Partial(false)
 	return resourceAlicloudHbrEcsBackupPlanRead(d, meta)
@@ -9,9 +9,9 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlicloudHbrNasBackupPlan() *schema.Resource {
@@ -256,12 +256,6 @@ func resourceAlicloudHbrNasBackupPlanUpdate(d *schema.ResourceData, meta interfa
 		if fmt.Sprint(response["Success"]) == "false" {
 			return WrapError(fmt.Errorf("%s failed, response: %v", action, response))
 		}
-		d.SetPartial("retention")
-		d.SetPartial("vault_id")
-		d.SetPartial("nas_backup_plan_name")
-		d.SetPartial("options")
-		d.SetPartial("path")
-		d.SetPartial("schedule")
 	}
 	if d.HasChange("disabled") {
 		object, err := hbrService.DescribeHbrNasBackupPlan(d.Id())
@@ -306,7 +300,6 @@ func resourceAlicloudHbrNasBackupPlanUpdate(d *schema.ResourceData, meta interfa
 			}
 		}
 	}
-	d.SetPartial("disabled")
 	d.Partial(false)
 	return resourceAlicloudHbrNasBackupPlanRead(d, meta)
 }
@@ -7,8 +7,8 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudHbrVault() *schema.Resource {
@@ -71,7 +71,7 @@ func resourceAliCloudHbrVault() *schema.Resource {
 				Optional:     true,
 				Computed:     true,
 				ValidateFunc: StringInSlice([]string{"LRS", "ZRS"}, false),
-				Removed:      "Field `redundancy_type` has been removed from provider version 1.209.1.",
+				Deprecated:   "Field `redundancy_type` has been removed from provider version 1.209.1.",
 			},
 			"status": {
 				Type:     schema.TypeString,
@@ -11,15 +11,15 @@ import (
 
 	"github.com/denverdino/aliyungo/common"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"encoding/base64"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudInstance() *schema.Resource {
@@ -31,6 +31,11 @@ func resourceAliCloudInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffAll(
+			customizeDiffPeriodOnlyWithPrePaid("instance_charge_type", string(common.PrePaid), "period"),
+			customizeDiffRequiredWhenValue("spot_strategy", []string{"SpotWithPriceLimit"}, "spot_price_limit"),
+			customizeDiffConflictsWhenValue("spot_strategy", []string{"NoSpot", "SpotAsPriceGo"}, "spot_price_limit"),
+		),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -145,7 +150,6 @@ func resourceAliCloudInstance() *schema.Resource {
 				Optional:   true,
 				Computed:   true,
 				Deprecated: "Attribute io_optimized has been deprecated on instance resource. All the launched alicloud instances will be IO optimized. Suggest to remove it from your template.",
-				Removed:    "Attribute 'io_optimized' has been removed from provider version 1.213.1.",
 			},
 			"is_outdated": {
 				Type:     schema.TypeBool,
@@ -348,10 +352,10 @@ func resourceAliCloudInstance() *schema.Resource {
 			},
 			//subnet_id and vswitch_id both exists, cause compatible old version, and aws habit.
 			"subnet_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true, //add this schema cause subnet_id not used enter parameter, will different, so will be ForceNew
-				Removed:  "Field 'subnet_id' has been removed from version 1.210.0",
+				Type:       schema.TypeString,
+				Optional:   true,
+				Computed:   true, //add this schema cause subnet_id not used enter parameter, will different, so will be ForceNew
+				Deprecated: "Field 'subnet_id' has been removed from version 1.210.0",
 			},
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -1485,7 +1489,6 @@ func resourceAliCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 		if err := setTags(client, TagResourceInstance, d); err != nil {
 			return WrapError(err)
 		} else {
-			d.SetPartial("tags")
 		}
 	}
 
@@ -1502,13 +1505,11 @@ func resourceAliCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
 		addDebug(action, response, request)
-		d.SetPartial("resource_group_id")
 	}
 
 	if err := setVolumeTags(client, TagResourceDisk, d); err != nil {
 		return WrapError(err)
 	} else {
-		d.SetPartial("volume_tags")
 	}
 
 	if !d.IsNewResource() && !d.HasChange("vpc_id") && d.HasChange("security_groups") {
@@ -1534,7 +1535,6 @@ func resourceAliCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 				}
 			}
 
-			d.SetPartial("security_groups")
 		}
 	}
 
@@ -1564,7 +1564,6 @@ func resourceAliCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-			d.SetPartial("system_disk_size")
 		}
 
 		if d.HasChange("system_disk_auto_snapshot_policy_id") {
@@ -1591,7 +1590,6 @@ func resourceAliCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 			if err != nil {
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 			}
-			d.SetPartial("system_disk_auto_snapshot_policy_id")
 		}
 
 		if d.HasChange("system_disk_name") || d.HasChange("system_disk_description") {
@@ -1618,8 +1616,6 @@ func resourceAliCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 			if err != nil {
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 			}
-			d.SetPartial("system_disk_name")
-			d.SetPartial("system_disk_description")
 		}
 	}
 
@@ -1650,7 +1646,6 @@ func resourceAliCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("auto_release_time")
 	}
 
 	typeUpdate, err := modifyInstanceType(d, meta, run)
@@ -1748,7 +1743,6 @@ func resourceAliCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 			}
 		}
 		if d.HasChange("status") {
-			d.SetPartial("status")
 		}
 	}
 
@@ -1757,7 +1751,6 @@ func resourceAliCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	if d.HasChange("force_delete") {
-		d.SetPartial("force_delete")
 	}
 
 	if err := modifyInstanceChargeType(d, meta, false); err != nil {
@@ -1784,8 +1777,6 @@ func resourceAliCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-		d.SetPartial("renewal_status")
-		d.SetPartial("auto_renew_period")
 	}
 
 	if d.HasChange("secondary_private_ips") {
@@ -1835,7 +1826,6 @@ func resourceAliCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 			}
 			addDebug(action, response, request)
-			d.SetPartial("secondary_private_ips")
 		}
 		if len(create) > 0 {
 			action := "AssignPrivateIpAddresses"
@@ -1863,7 +1853,6 @@ func resourceAliCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 			if err != nil {
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 			}
-			d.SetPartial("secondary_private_ips")
 		}
 
 	}
@@ -1912,7 +1901,6 @@ func resourceAliCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 				if err != nil {
 					return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 				}
-				d.SetPartial("secondary_private_ip_address_count")
 			}
 			if diff < 0 {
 				diff *= -1
@@ -1942,7 +1930,6 @@ func resourceAliCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 					return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 				}
 				addDebug(action, response, request)
-				d.SetPartial("secondary_private_ip_address_count")
 			}
 		}
 	}
@@ -1978,7 +1965,6 @@ func resourceAliCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("deployment_set_id")
 	}
 
 	if d.HasChange("maintenance_time") || d.HasChange("maintenance_action") || d.HasChange("maintenance_notify") {
@@ -2029,9 +2015,6 @@ func resourceAliCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
 
-		d.SetPartial("maintenance_time")
-		d.SetPartial("maintenance_action")
-		d.SetPartial("maintenance_notify")
 	}
 
 	if d.HasChange("http_endpoint") || d.HasChange("http_tokens") {
@@ -2068,8 +2051,6 @@ func resourceAliCloudInstanceUpdate(d *schema.ResourceData, meta interface{}) er
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
 
-		d.SetPartial("http_endpoint")
-		d.SetPartial("http_tokens")
 	}
 
 	d.Partial(false)
@@ -2183,7 +2164,6 @@ func modifyInstanceChargeType(d *schema.ResourceData, meta interface{}, forceDel
 			return WrapError(err)
 		}
 
-		d.SetPartial("instance_charge_type")
 		return nil
 	}
 
@@ -2296,19 +2276,16 @@ func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, er
 	request["InstanceId"] = d.Id()
 
 	if d.HasChange("instance_name") {
-		d.SetPartial("instance_name")
 		request["InstanceName"] = d.Get("instance_name").(string)
 		update = true
 	}
 
 	if d.HasChange("description") {
-		d.SetPartial("description")
 		request["Description"] = d.Get("description").(string)
 		update = true
 	}
 
 	if d.HasChange("user_data") {
-		d.SetPartial("user_data")
 		v := d.Get("user_data")
 		_, base64DecodeError := base64.StdEncoding.DecodeString(v.(string))
 		if base64DecodeError == nil {
@@ -2322,7 +2299,6 @@ func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, er
 	}
 
 	if d.HasChange("host_name") {
-		d.SetPartial("host_name")
 		request["HostName"] = d.Get("host_name").(string)
 		update = true
 		reboot = true
@@ -2330,7 +2306,6 @@ func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, er
 
 	if d.HasChange("password") || d.HasChange("kms_encrypted_password") {
 		if v := d.Get("password").(string); v != "" {
-			d.SetPartial("password")
 			request["Password"] = v
 			update = true
 			reboot = true
@@ -2342,27 +2317,22 @@ func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, er
 				return reboot, WrapError(err)
 			}
 			request["Password"] = decryptResp
-			d.SetPartial("kms_encrypted_password")
-			d.SetPartial("kms_encryption_context")
 			update = true
 			reboot = true
 		}
 	}
 
 	if d.HasChange("deletion_protection") {
-		d.SetPartial("deletion_protection")
 		request["DeletionProtection"] = requests.NewBoolean(d.Get("deletion_protection").(bool))
 		update = true
 	}
 
 	if d.HasChange("credit_specification") {
-		d.SetPartial("credit_specification")
 		request["CreditSpecification"] = d.Get("credit_specification").(string)
 		update = true
 	}
 
 	if d.HasChange("enable_jumbo_frame") {
-		d.SetPartial("enable_jumbo_frame")
 
 		if v, ok := d.GetOkExists("enable_jumbo_frame"); ok {
 			request["EnableJumboFrame"] = v
@@ -2469,11 +2439,6 @@ func modifyVpcAttribute(d *schema.ResourceData, meta interface{}, run bool) (boo
 			return update, WrapError(err)
 		}
 
-		d.SetPartial("vswitch_id")
-		d.SetPartial("subnet_id")
-		d.SetPartial("private_ip")
-		d.SetPartial("vpc_id")
-		d.SetPartial("security_groups")
 	}
 
 	return update, nil
@@ -2575,7 +2540,6 @@ func modifyInstanceType(d *schema.ResourceData, meta interface{}, run bool) (boo
 
 			time.Sleep(DefaultIntervalShort * time.Second)
 		}
-		d.SetPartial("instance_type")
 	}
 	return update, nil
 }
@@ -2595,7 +2559,6 @@ func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("internet_charge_type") {
 		request.NetworkChargeType = d.Get("internet_charge_type").(string)
 		update = true
-		d.SetPartial("internet_charge_type")
 	}
 
 	if d.HasChange("internet_max_bandwidth_out") {
@@ -2605,13 +2568,11 @@ func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 		}
 		request.InternetMaxBandwidthOut = requests.NewInteger(n.(int))
 		update = true
-		d.SetPartial("internet_max_bandwidth_out")
 	}
 
 	if d.HasChange("internet_max_bandwidth_in") {
 		request.InternetMaxBandwidthIn = requests.NewInteger(d.Get("internet_max_bandwidth_in").(int))
 		update = true
-		d.SetPartial("internet_max_bandwidth_in")
 	}
 
 	//An instance that was successfully modified once cannot be modified again within 5 minutes.
@@ -10,11 +10,11 @@ import (
 	"github.com/alibabacloud-go/tea-rpc/client"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestAccAlicloudKMSKeyVersion_basic(t *testing.T) {
@@ -102,7 +102,7 @@ func resourceKMSKeyVersionConfigDependence(name string) string {
 }
 
 func TestUnitAlicloudKMSKeyVersion(t *testing.T) {
-	p := Provider().(*schema.Provider).ResourcesMap
+	p := Provider().ResourcesMap
 	dInit, _ := schema.InternalMap(p["alicloud_kms_key_version"].Schema).Data(nil, nil)
 	dExisted, _ := schema.InternalMap(p["alicloud_kms_key_version"].Schema).Data(nil, nil)
 	dInit.MarkNewResource()
@@ -11,8 +11,8 @@ import (
 	util "github.com/alibabacloud-go/tea-utils/v2/service"
 	sls "github.com/aliyun/aliyun-log-go-sdk"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudSlsProject() *schema.Resource {
@@ -275,7 +275,6 @@ func resourceAliCloudSlsProjectUpdate(d *schema.ResourceData, meta interface{})
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateProjectPolicy", AliyunLogGoSdkERROR)
 		}
 		addDebug("UpdateProjectPolicy", raw, requestInfo, request)
-		d.SetPartial("policy")
 	}
 	d.Partial(false)
 	return resourceAliCloudSlsProjectRead(d, meta)
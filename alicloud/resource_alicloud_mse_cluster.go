@@ -8,8 +8,8 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudMseCluster() *schema.Resource {
@@ -310,7 +310,6 @@ func resourceAlicloudMseClusterUpdate(d *schema.ResourceData, meta interface{})
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("resource_group_id")
 	}
 
 	if d.HasChange("acl_entry_list") {
@@ -338,7 +337,6 @@ func resourceAlicloudMseClusterUpdate(d *schema.ResourceData, meta interface{})
 		if fmt.Sprint(response["Success"]) == "false" {
 			return WrapErrorf(fmt.Errorf("%s failed, response: %v", action, response), DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("acl_entry_list")
 	}
 	update = false
 	request = map[string]interface{}{
@@ -369,7 +367,6 @@ func resourceAlicloudMseClusterUpdate(d *schema.ResourceData, meta interface{})
 		if fmt.Sprint(response["Success"]) == "false" {
 			return WrapErrorf(fmt.Errorf("%s failed, response: %v", action, response), DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("cluster_alias_name")
 	}
 
 	update = false
@@ -420,8 +417,6 @@ func resourceAlicloudMseClusterUpdate(d *schema.ResourceData, meta interface{})
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("cluster_specification")
-		d.SetPartial("instance_count")
 	}
 
 	update = false
@@ -431,7 +426,6 @@ func resourceAlicloudMseClusterUpdate(d *schema.ResourceData, meta interface{})
 		if err := mseServiceV2.SetResourceTags(d, "CLUSTER"); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 
 	if !d.IsNewResource() && d.HasChanges("vpc_id", "vswitch_id") {
@@ -467,8 +461,6 @@ func resourceAlicloudMseClusterUpdate(d *schema.ResourceData, meta interface{})
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("vpc_id")
-		d.SetPartial("vswitch_id")
 	}
 
 	if !d.IsNewResource() && d.HasChange("pub_network_flow") {
@@ -505,7 +497,6 @@ func resourceAlicloudMseClusterUpdate(d *schema.ResourceData, meta interface{})
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("pub_network_flow")
 	}
 
 	d.Partial(false)
@@ -9,9 +9,9 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAliCloudNatGateway() *schema.Resource {
@@ -101,41 +101,41 @@ func resourceAliCloudNatGateway() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"ip_count": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Removed:  "Field `ip_count` has been removed from provider version 1.121.0.",
+							Type:       schema.TypeInt,
+							Optional:   true,
+							Deprecated: "Field `ip_count` has been removed from provider version 1.121.0.",
 						},
 						"bandwidth": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Removed:  "Field `bandwidth` has been removed from provider version 1.121.0.",
+							Type:       schema.TypeInt,
+							Optional:   true,
+							Deprecated: "Field `bandwidth` has been removed from provider version 1.121.0.",
 						},
 						"zone": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-							Removed:  "Field `zone` has been removed from provider version 1.121.0.",
+							Type:       schema.TypeString,
+							Optional:   true,
+							Computed:   true,
+							Deprecated: "Field `zone` has been removed from provider version 1.121.0.",
 						},
 						"public_ip_addresses": {
-							Type:     schema.TypeString,
-							Computed: true,
-							Removed:  "Field `public_ip_addresses` has been removed from provider version 1.121.0.",
+							Type:       schema.TypeString,
+							Computed:   true,
+							Deprecated: "Field `public_ip_addresses` has been removed from provider version 1.121.0.",
 						},
 					},
 				},
-				MaxItems: 4,
-				Optional: true,
-				Removed:  "Field `bandwidth_packages` has been removed from provider version 1.121.0.",
+				MaxItems:   4,
+				Optional:   true,
+				Deprecated: "Field `bandwidth_packages` has been removed from provider version 1.121.0.",
 			},
 			"bandwidth_package_ids": {
-				Type:     schema.TypeString,
-				Computed: true,
-				Removed:  "Field `bandwidth_package_ids` has been removed from provider version 1.121.0.",
+				Type:       schema.TypeString,
+				Computed:   true,
+				Deprecated: "Field `bandwidth_package_ids` has been removed from provider version 1.121.0.",
 			},
 			"spec": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Removed:  "Field `spec` has been removed from provider version 1.121.0. New field `specification` instead.",
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "Field `spec` has been removed from provider version 1.121.0. New field `specification` instead.",
 			},
 			"snat_table_ids": {
 				Type:     schema.TypeString,
@@ -429,7 +429,6 @@ func resourceAliCloudNatGatewayUpdate(d *schema.ResourceData, meta interface{})
 		if err := vpcService.SetResourceTags(d, "NATGATEWAY"); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 
 	if d.HasChange("deletion_protection") {
@@ -467,7 +466,6 @@ func resourceAliCloudNatGatewayUpdate(d *schema.ResourceData, meta interface{})
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
 
-		d.SetPartial("deletion_protection")
 	}
 
 	update := false
@@ -529,9 +527,6 @@ func resourceAliCloudNatGatewayUpdate(d *schema.ResourceData, meta interface{})
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("description")
-		d.SetPartial("name")
-		d.SetPartial("nat_gateway_name")
 	}
 
 	if !d.IsNewResource() && d.HasChange("specification") {
@@ -568,7 +563,6 @@ func resourceAliCloudNatGatewayUpdate(d *schema.ResourceData, meta interface{})
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("specification")
 	}
 	update = false
 	updateNatGatewayNatTypeReq := map[string]interface{}{
@@ -618,9 +612,6 @@ func resourceAliCloudNatGatewayUpdate(d *schema.ResourceData, meta interface{})
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		d.SetPartial("nat_type")
-		d.SetPartial("vswitch_id")
-		d.SetPartial("dry_run")
 	}
 
 	d.Partial(false)
@@ -1,6 +1,7 @@
 package alicloud
 
 import (
+	"context"
 	"fmt"
 	"hash/crc32"
 	"log"
@@ -10,8 +11,8 @@ import (
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudNlbLoadBalancer() *schema.Resource {
@@ -226,7 +227,7 @@ func resourceAliCloudNlbLoadBalancer() *schema.Resource {
 				},
 			},
 		},
-		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+		CustomizeDiff: func(ctx context.Context, diff *schema.ResourceDiff, v interface{}) error {
 			for _, key := range diff.GetChangedKeysPrefix("zone_mappings") {
 				// If the set contains computed key, there are some diff changes when one of element has been changed,
 				// and there aims to ignore the diff
@@ -517,7 +518,6 @@ func resourceAliCloudNlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("address_type")
 	}
 	update = false
 	action = "UpdateLoadBalancerAttribute"
@@ -570,8 +570,6 @@ func resourceAliCloudNlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("load_balancer_name")
-		d.SetPartial("cross_zone_enabled")
 	}
 	update = false
 	action = "UpdateLoadBalancerZones"
@@ -720,10 +718,6 @@ func resourceAliCloudNlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("enabled")
-		d.SetPartial("reason")
-		d.SetPartial("status")
-		d.SetPartial("reason")
 	}
 	update = false
 	action = "MoveResourceGroup"
@@ -763,7 +757,6 @@ func resourceAliCloudNlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("resource_group_id")
 	}
 
 	if d.HasChange("ipv6_address_type") {
@@ -994,7 +987,6 @@ func resourceAliCloudNlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 			}
 		}
 
-		d.SetPartial("bandwidth_package_id")
 	}
 
 	if d.HasChange("tags") {
@@ -1002,7 +994,6 @@ func resourceAliCloudNlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 		if err := nlbServiceV2.SetResourceTags(d, "loadbalancer"); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 	if d.HasChange("security_group_ids") {
 		oldEntry, newEntry := d.GetChange("security_group_ids")
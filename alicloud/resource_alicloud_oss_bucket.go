@@ -10,8 +10,8 @@ import (
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/hashcode"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudOssBucket() *schema.Resource {
@@ -966,35 +966,30 @@ func resourceAlicloudOssBucketUpdate(d *schema.ResourceData, meta interface{}) e
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "SetBucketACL", AliyunOssGoSdk)
 		}
 		addDebug("SetBucketACL", raw, requestInfo, request)
-		d.SetPartial("acl")
 	}
 
 	if d.HasChange("cors_rule") {
 		if err := resourceAlicloudOssBucketCorsUpdate(client, d); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("cors_rule")
 	}
 
 	if d.HasChange("website") {
 		if err := resourceAlicloudOssBucketWebsiteUpdate(client, d); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("website")
 	}
 
 	if d.HasChange("logging") {
 		if err := resourceAlicloudOssBucketLoggingUpdate(client, d); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("logging")
 	}
 
 	if d.HasChange("referer_config") {
 		if err := resourceAlicloudOssBucketRefererUpdate(client, d); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("referer_config")
 	}
 
 	//set access_monitor status to enable before lifecycle rule
@@ -1010,7 +1005,6 @@ func resourceAlicloudOssBucketUpdate(d *schema.ResourceData, meta interface{}) e
 		if err := resourceAlicloudOssBucketLifecycleRuleUpdate(client, d); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("lifecycle_rule")
 	}
 
 	//set access_monitor status to disable after lifecycle rule
@@ -1018,42 +1012,36 @@ func resourceAlicloudOssBucketUpdate(d *schema.ResourceData, meta interface{}) e
 		if err := resourceAlicloudOssBucketAccessMonitorUpdate(client, d, false); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("access_monitor")
 	}
 
 	if d.HasChange("policy") {
 		if err := resourceAlicloudOssBucketPolicyUpdate(client, d); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("policy")
 	}
 
 	if d.HasChange("server_side_encryption_rule") {
 		if err := resourceAlicloudOssBucketEncryptionUpdate(client, d); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("server_side_encryption_rule")
 	}
 
 	if d.HasChange("tags") {
 		if err := resourceAlicloudOssBucketTaggingUpdate(client, d); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 
 	if d.HasChange("versioning") {
 		if err := resourceAlicloudOssBucketVersioningUpdate(client, d); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("versioning")
 	}
 
 	if d.HasChange("transfer_acceleration") {
 		if err := resourceAlicloudOssBucketTransferAccUpdate(client, d); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("transfer_acceleration")
 	}
 
 	if !d.IsNewResource() && d.HasChange("resource_group_id") {
@@ -1070,7 +1058,6 @@ func resourceAlicloudOssBucketUpdate(d *schema.ResourceData, meta interface{}) e
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "PutBucketResourceGroup", AliyunOssGoSdk)
 		}
 		addDebug("PutBucketResourceGroup", raw, requestInfo, request)
-		d.SetPartial("resource_group_id")
 	}
 
 	d.Partial(false)
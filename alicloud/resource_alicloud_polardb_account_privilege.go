@@ -6,9 +6,9 @@ import (
 	"time"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlicloudPolarDBAccountPrivilege() *schema.Resource {
@@ -151,7 +151,6 @@ func resourceAlicloudPolarDBAccountPrivilegeUpdate(d *schema.ResourceData, meta
 				}
 			}
 		}
-		d.SetPartial("db_names")
 	}
 
 	d.Partial(false)
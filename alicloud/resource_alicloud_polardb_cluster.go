@@ -15,8 +15,8 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/polardb"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudPolarDBCluster() *schema.Resource {
@@ -593,7 +593,6 @@ func resourceAlicloudPolarDBClusterUpdate(d *schema.ResourceData, meta interface
 		if err := polarDBService.ModifyParameters(d); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("parameters")
 	}
 
 	if err := polarDBService.setClusterTags(d); err != nil {
@@ -645,9 +644,7 @@ func resourceAlicloudPolarDBClusterUpdate(d *schema.ResourceData, meta interface
 			return WrapError(err)
 		}
 		if payType == string(PrePaid) {
-			d.SetPartial("period")
 		}
-		d.SetPartial("pay_type")
 	}
 
 	if (d.Get("pay_type").(string) == string(PrePaid)) &&
@@ -688,8 +685,6 @@ func resourceAlicloudPolarDBClusterUpdate(d *schema.ResourceData, meta interface
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("renewal_status")
-		d.SetPartial("auto_renew_period")
 	}
 
 	if d.HasChange("maintain_time") {
@@ -705,7 +700,6 @@ func resourceAlicloudPolarDBClusterUpdate(d *schema.ResourceData, meta interface
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-		d.SetPartial("maintain_time")
 	}
 
 	if !d.IsNewResource() && d.HasChanges("upgrade_type", "from_time_service", "planned_start_time", "planned_end_time", "target_db_revision_version_code") {
@@ -766,11 +760,6 @@ func resourceAlicloudPolarDBClusterUpdate(d *schema.ResourceData, meta interface
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
 		}
-		d.SetPartial("upgrade_type")
-		d.SetPartial("from_time_service")
-		d.SetPartial("planned_start_time")
-		d.SetPartial("planned_end_time")
-		d.SetPartial("target_db_revision_version_code")
 
 	}
 
@@ -779,7 +768,6 @@ func resourceAlicloudPolarDBClusterUpdate(d *schema.ResourceData, meta interface
 		if err := polarDBService.ModifyDBClusterAccessWhitelist(d); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("db_cluster_ip_array")
 	}
 
 	if !d.IsNewResource() && d.HasChange("security_ips") {
@@ -794,7 +782,6 @@ func resourceAlicloudPolarDBClusterUpdate(d *schema.ResourceData, meta interface
 		if err := polarDBService.ModifyDBSecurityIps(d.Id(), ipstr); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("security_ips")
 	}
 
 	if v, ok := d.GetOk("creation_category"); !ok || v.(string) != "Basic" {
@@ -901,7 +888,6 @@ func resourceAlicloudPolarDBClusterUpdate(d *schema.ResourceData, meta interface
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-		d.SetPartial("collector_status")
 	}
 
 	if v, ok := d.GetOk("db_type"); ok && v.(string) == "MySQL" {
@@ -944,10 +930,6 @@ func resourceAlicloudPolarDBClusterUpdate(d *schema.ResourceData, meta interface
 				if _, err := stateConf.WaitForState(); err != nil {
 					return WrapErrorf(err, IdMsg, d.Id())
 				}
-				d.SetPartial("tde_status")
-				d.SetPartial("encrypt_new_tables")
-				d.SetPartial("encryption_key")
-				d.SetPartial("role_arn")
 			}
 		}
 	}
@@ -968,7 +950,6 @@ func resourceAlicloudPolarDBClusterUpdate(d *schema.ResourceData, meta interface
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-		d.SetPartial("security_group_ids")
 	}
 
 	if d.HasChange("deletion_lock") {
@@ -999,7 +980,6 @@ func resourceAlicloudPolarDBClusterUpdate(d *schema.ResourceData, meta interface
 			}
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, ProviderERROR)
 		}
-		d.SetPartial("deletion_lock")
 	}
 
 	if d.HasChange("serverless_steady_switch") {
@@ -1072,12 +1052,6 @@ func resourceAlicloudPolarDBClusterUpdate(d *schema.ResourceData, meta interface
 			if _, err := stateConf.WaitForState(); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
-			d.SetPartial("scale_min")
-			d.SetPartial("scale_max")
-			d.SetPartial("scale_ro_num_min")
-			d.SetPartial("scale_ro_num_max")
-			d.SetPartial("scale_ap_ro_num_min")
-			d.SetPartial("scale_ap_ro_num_max")
 		}
 		// Turn off steady state
 		if u, ok := d.GetOk("serverless_steady_switch"); ok {
@@ -1148,7 +1122,6 @@ func resourceAlicloudPolarDBClusterUpdate(d *schema.ResourceData, meta interface
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("compress_storage")
 	}
 
 	if d.IsNewResource() {
@@ -1193,7 +1166,6 @@ func resourceAlicloudPolarDBClusterUpdate(d *schema.ResourceData, meta interface
 			if _, err := stateConf.WaitForState(); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
-			d.SetPartial("db_node_class")
 		}
 	}
 
@@ -1210,7 +1182,6 @@ func resourceAlicloudPolarDBClusterUpdate(d *schema.ResourceData, meta interface
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-		d.SetPartial("description")
 	}
 
 	if !d.IsNewResource() && d.HasChanges("scale_min", "scale_max", "allow_shut_down", "scale_ro_num_min", "scale_ro_num_max", "seconds_until_auto_pause", "scale_ap_ro_num_min", "scale_ap_ro_num_max") {
@@ -1283,12 +1254,6 @@ func resourceAlicloudPolarDBClusterUpdate(d *schema.ResourceData, meta interface
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("scale_min")
-		d.SetPartial("scale_max")
-		d.SetPartial("scale_ro_num_min")
-		d.SetPartial("scale_ro_num_max")
-		d.SetPartial("allow_shut_down")
-		d.SetPartial("seconds_until_auto_pause")
 	}
 
 	if d.HasChange("storage_space") {
@@ -1330,7 +1295,6 @@ func resourceAlicloudPolarDBClusterUpdate(d *schema.ResourceData, meta interface
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("storage_space")
 	}
 
 	if d.HasChange("hot_replica_mode") {
@@ -1377,8 +1341,6 @@ func resourceAlicloudPolarDBClusterUpdate(d *schema.ResourceData, meta interface
 			if _, err := stateConf.WaitForState(); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
-			d.SetPartial("hot_replica_mode")
-			d.SetPartial("db_node_id")
 		}
 	}
 
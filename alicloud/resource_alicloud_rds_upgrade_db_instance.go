@@ -8,9 +8,9 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlicloudRdsUpgradeDbInstance() *schema.Resource {
@@ -632,7 +632,6 @@ func resourceAlicloudRdsUpgradeDbInstanceUpdate(d *schema.ResourceData, meta int
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("auto_upgrade_minor_version")
 	}
 	update = false
 	modifyDBInstanceDescriptionReq := map[string]interface{}{
@@ -666,7 +665,6 @@ func resourceAlicloudRdsUpgradeDbInstanceUpdate(d *schema.ResourceData, meta int
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("db_instance_description")
 	}
 	update = false
 	modifyDBInstanceMaintainTimeReq := map[string]interface{}{
@@ -703,7 +701,6 @@ func resourceAlicloudRdsUpgradeDbInstanceUpdate(d *schema.ResourceData, meta int
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("maintain_time")
 	}
 	update = false
 	modifyDBInstanceHAConfigReq := map[string]interface{}{
@@ -743,7 +740,6 @@ func resourceAlicloudRdsUpgradeDbInstanceUpdate(d *schema.ResourceData, meta int
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("sync_mode")
 	}
 	update = false
 	switchDBInstanceVpcReq := map[string]interface{}{
@@ -794,9 +790,6 @@ func resourceAlicloudRdsUpgradeDbInstanceUpdate(d *schema.ResourceData, meta int
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("private_ip_address")
-		d.SetPartial("vpc_id")
-		d.SetPartial("vswitch_id")
 	}
 	update = false
 	modifyDBInstanceConnectionStringReq := map[string]interface{}{
@@ -844,7 +837,6 @@ func resourceAlicloudRdsUpgradeDbInstanceUpdate(d *schema.ResourceData, meta int
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("connection_string")
 	}
 	update = false
 	modifyDBInstanceTDEReq := map[string]interface{}{
@@ -901,7 +893,6 @@ func resourceAlicloudRdsUpgradeDbInstanceUpdate(d *schema.ResourceData, meta int
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("encryption_key")
 	}
 	update = false
 	modifySecurityIpsReq := map[string]interface{}{
@@ -938,7 +929,6 @@ func resourceAlicloudRdsUpgradeDbInstanceUpdate(d *schema.ResourceData, meta int
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("security_ips")
 	}
 	update = false
 	modifyDBInstanceSSLReq := map[string]interface{}{
@@ -1041,15 +1031,6 @@ func resourceAlicloudRdsUpgradeDbInstanceUpdate(d *schema.ResourceData, meta int
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("connection_string")
-		d.SetPartial("acl")
-		d.SetPartial("ca_type")
-		d.SetPartial("client_ca_cert")
-		d.SetPartial("client_cert_revocation_list")
-		d.SetPartial("replication_acl")
-		d.SetPartial("server_cert")
-		d.SetPartial("server_key")
-		d.SetPartial("ssl_enabled")
 	}
 	if d.HasChange("resource_group_id") {
 		if v, ok := d.GetOk("resource_group_id"); ok {
@@ -1082,7 +1063,6 @@ func resourceAlicloudRdsUpgradeDbInstanceUpdate(d *schema.ResourceData, meta int
 			if _, err := stateConf.WaitForState(); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
-			d.SetPartial("resource_group_id")
 		}
 	}
 
@@ -1169,12 +1149,6 @@ func resourceAlicloudRdsUpgradeDbInstanceUpdate(d *schema.ResourceData, meta int
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("db_instance_class")
-		d.SetPartial("db_instance_storage")
-		d.SetPartial("db_instance_storage_type")
-		d.SetPartial("dedicated_host_group_id")
-		d.SetPartial("engine_version")
-		d.SetPartial("zone_id")
 	}
 	d.Partial(false)
 	return resourceAlicloudRdsUpgradeDbInstanceRead(d, meta)
@@ -7,8 +7,8 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudResourceManagerAccount() *schema.Resource {
@@ -171,7 +171,6 @@ func resourceAlicloudResourceManagerAccountUpdate(d *schema.ResourceData, meta i
 		if err := resourcemanagerService.SetResourceTags(d, "Account"); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 	if d.HasChange("folder_id") && !d.IsNewResource() {
 		request := map[string]interface{}{
@@ -195,7 +194,6 @@ func resourceAlicloudResourceManagerAccountUpdate(d *schema.ResourceData, meta i
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("folder_id")
 	}
 	update := false
 	request := map[string]interface{}{
@@ -223,7 +221,6 @@ func resourceAlicloudResourceManagerAccountUpdate(d *schema.ResourceData, meta i
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("display_name")
 	}
 	d.Partial(false)
 	return resourceAlicloudResourceManagerAccountRead(d, meta)
@@ -9,8 +9,8 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudResourceManagerResourceDirectory() *schema.Resource {
@@ -198,7 +198,6 @@ func resourceAliCloudResourceManagerResourceDirectoryUpdate(d *schema.ResourceDa
 				}
 			}
 
-			d.SetPartial("status")
 		}
 	}
 
@@ -241,7 +240,6 @@ func resourceAliCloudResourceManagerResourceDirectoryUpdate(d *schema.ResourceDa
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 			}
 
-			d.SetPartial("member_deletion_status")
 		}
 	}
 
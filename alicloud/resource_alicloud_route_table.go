@@ -9,8 +9,8 @@ import (
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudVpcRouteTable() *schema.Resource {
@@ -208,8 +208,6 @@ func resourceAliCloudVpcRouteTableUpdate(d *schema.ResourceData, meta interface{
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("route_table_name")
-		d.SetPartial("description")
 	}
 
 	update = false
@@ -219,7 +217,6 @@ func resourceAliCloudVpcRouteTableUpdate(d *schema.ResourceData, meta interface{
 		if err := vpcServiceV2.SetResourceTags(d, "ROUTETABLE"); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 	d.Partial(false)
 	return resourceAliCloudVpcRouteTableRead(d, meta)
@@ -10,13 +10,13 @@ import (
 	"github.com/alibabacloud-go/tea-rpc/client"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestAccAlicloudSDDPDataLimit_basic0(t *testing.T) {
@@ -177,7 +177,7 @@ resource "alicloud_db_account_privilege" "default" {
 }
 
 func TestUnitAlicloudSDDPDataLimit(t *testing.T) {
-	p := Provider().(*schema.Provider).ResourcesMap
+	p := Provider().ResourcesMap
 	d, _ := schema.InternalMap(p["alicloud_sddp_data_limit"].Schema).Data(nil, nil)
 	dCreate, _ := schema.InternalMap(p["alicloud_sddp_data_limit"].Schema).Data(nil, nil)
 	dCreate.MarkNewResource()
@@ -349,8 +349,8 @@ func TestUnitAlicloudSDDPDataLimit(t *testing.T) {
 
 	t.Run("UpdateModifySDDPDataLimitAttributeAbnormal", func(t *testing.T) {
 		diff := terraform.NewInstanceDiff()
-		diff.SetAttribute("audit_status", &terraform.ResourceAttrDiff{Old: "0", New: "1"})
-		diff.SetAttribute("log_store_day", &terraform.ResourceAttrDiff{Old: "30", New: "365"})
+		diff.Attributes["audit_status"] = &terraform.ResourceAttrDiff{Old: "0", New: "1"}
+		diff.Attributes["log_store_day"] = &terraform.ResourceAttrDiff{Old: "30", New: "365"}
 		resourceData1, _ := schema.InternalMap(p["alicloud_sddp_data_limit"].Schema).Data(nil, diff)
 		resourceData1.SetId(d.Id())
 		retryFlag := true
@@ -371,8 +371,8 @@ func TestUnitAlicloudSDDPDataLimit(t *testing.T) {
 	})
 	t.Run("UpdateModifySDDPDataLimitAttributeNoRetryErrorAbnormal", func(t *testing.T) {
 		diff := terraform.NewInstanceDiff()
-		diff.SetAttribute("audit_status", &terraform.ResourceAttrDiff{Old: "0", New: "1"})
-		diff.SetAttribute("log_store_day", &terraform.ResourceAttrDiff{Old: "30", New: "365"})
+		diff.Attributes["audit_status"] = &terraform.ResourceAttrDiff{Old: "0", New: "1"}
+		diff.Attributes["log_store_day"] = &terraform.ResourceAttrDiff{Old: "30", New: "365"}
 		resourceData, _ := schema.InternalMap(p["alicloud_sddp_data_limit"].Schema).Data(nil, diff)
 		resourceData.SetId(d.Id())
 		patches := gomonkey.ApplyMethod(reflect.TypeOf(&client.Client{}), "DoRequest", func(_ *client.Client, _ *string, _ *string, _ *string, _ *string, _ *string, _ map[string]interface{}, _ map[string]interface{}, _ *util.RuntimeOptions) (map[string]interface{}, error) {
@@ -388,9 +388,9 @@ func TestUnitAlicloudSDDPDataLimit(t *testing.T) {
 	})
 	t.Run("UpdateModifySDDPDataLimitAttributeNormal", func(t *testing.T) {
 		diff := terraform.NewInstanceDiff()
-		diff.SetAttribute("audit_status", &terraform.ResourceAttrDiff{Old: "0", New: "1"})
-		diff.SetAttribute("log_store_day", &terraform.ResourceAttrDiff{Old: "30", New: "365"})
-		diff.SetAttribute("lang", &terraform.ResourceAttrDiff{Old: "zh", New: "us"})
+		diff.Attributes["audit_status"] = &terraform.ResourceAttrDiff{Old: "0", New: "1"}
+		diff.Attributes["log_store_day"] = &terraform.ResourceAttrDiff{Old: "30", New: "365"}
+		diff.Attributes["lang"] = &terraform.ResourceAttrDiff{Old: "zh", New: "us"}
 		resourceData1, _ := schema.InternalMap(p["alicloud_sddp_data_limit"].Schema).Data(nil, diff)
 		resourceData1.SetId(d.Id())
 		patches := gomonkey.ApplyMethod(reflect.TypeOf(&client.Client{}), "DoRequest", func(_ *client.Client, _ *string, _ *string, _ *string, _ *string, _ *string, _ map[string]interface{}, _ map[string]interface{}, _ *util.RuntimeOptions) (map[string]interface{}, error) {
@@ -8,13 +8,13 @@ import (
 	"github.com/agiledragon/gomonkey/v2"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
 
 	"github.com/alibabacloud-go/tea-rpc/client"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestAccAlicloudSASServiceLinkedRole_basic0(t *testing.T) {
@@ -59,7 +59,7 @@ func testAccCheckAlicloudSecurityCenterServiceLinkedRoleDependence(name string)
 }
 
 func TestUnitAlicloudSASServiceLinkedRole(t *testing.T) {
-	p := Provider().(*schema.Provider).ResourcesMap
+	p := Provider().ResourcesMap
 	d, _ := schema.InternalMap(p["alicloud_security_center_service_linked_role"].Schema).Data(nil, nil)
 	dCreate, _ := schema.InternalMap(p["alicloud_security_center_service_linked_role"].Schema).Data(nil, nil)
 	dCreate.MarkNewResource()
@@ -8,7 +8,7 @@ import (
 
 	"github.com/PaesslerAG/jsonpath"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudSelectDBDbCluster() *schema.Resource {
@@ -202,9 +202,7 @@ func resourceAlicloudSelectDBDbClusterUpdate(d *schema.ResourceData, meta interf
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("db_cluster_class")
 		if d.HasChange("cache_size") {
-			d.SetPartial("cache_size")
 		}
 	}
 
@@ -223,9 +221,7 @@ func resourceAlicloudSelectDBDbClusterUpdate(d *schema.ResourceData, meta interf
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("cache_size")
 		if d.HasChange("db_cluster_class") {
-			d.SetPartial("db_cluster_class")
 		}
 	}
 
@@ -235,7 +231,6 @@ func resourceAlicloudSelectDBDbClusterUpdate(d *schema.ResourceData, meta interf
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "ModifyBEClusterAttribute", AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("db_cluster_description")
 	}
 
 	if !d.IsNewResource() && d.HasChange("desired_status") {
@@ -254,7 +249,6 @@ func resourceAlicloudSelectDBDbClusterUpdate(d *schema.ResourceData, meta interf
 			if _, err := stateConf.WaitForState(); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
-			d.SetPartial("desired_status")
 		}
 	}
 
@@ -283,7 +277,6 @@ func resourceAlicloudSelectDBDbClusterUpdate(d *schema.ResourceData, meta interf
 		if _, err := selectDBService.UpdateSelectDBDbClusterConfig(d.Id(), diffConfig); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("desired_params")
 
 		stateConf := BuildStateConf([]string{"RESTARTING", "MODIFY_PARAM"}, []string{"ACTIVATION"}, d.Timeout(schema.TimeoutUpdate), 10*time.Second, selectDBService.SelectDBDbClusterStateRefreshFunc(d.Id(), []string{}))
 		if _, err := stateConf.WaitForState(); err != nil {
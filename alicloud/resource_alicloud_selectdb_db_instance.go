@@ -9,7 +9,7 @@ import (
 
 	"github.com/PaesslerAG/jsonpath"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudSelectDBDbInstance() *schema.Resource {
@@ -317,7 +317,6 @@ func resourceAlicloudSelectDBDbInstanceUpdate(d *schema.ResourceData, meta inter
 		if _, err := selectDBService.ModifySelectDBInstancePaymentType(d.Id(), request); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("payment_type")
 
 	}
 
@@ -344,8 +343,6 @@ func resourceAlicloudSelectDBDbInstanceUpdate(d *schema.ResourceData, meta inter
 			if _, err := stateConf.WaitForState(); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
-			d.SetPartial("enable_public_network")
-			d.SetPartial("instance_net_infos")
 		} else if !oldNetStatus.(bool) && newNetStatus.(bool) {
 			if _, err := selectDBService.AllocateSelectDBInstancePublicConnection(d.Id()); err != nil {
 				return WrapError(err)
@@ -354,8 +351,6 @@ func resourceAlicloudSelectDBDbInstanceUpdate(d *schema.ResourceData, meta inter
 			if _, err := stateConf.WaitForState(); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
-			d.SetPartial("enable_public_network")
-			d.SetPartial("instance_net_infos")
 		}
 
 	}
@@ -388,7 +383,6 @@ func resourceAlicloudSelectDBDbInstanceUpdate(d *schema.ResourceData, meta inter
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("upgraded_engine_minor_version")
 
 	}
 
@@ -410,9 +404,7 @@ func resourceAlicloudSelectDBDbInstanceUpdate(d *schema.ResourceData, meta inter
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, defaultBeId)
 		}
-		d.SetPartial("db_instance_class")
 		if d.HasChange("cache_size") {
-			d.SetPartial("cache_size")
 		}
 	}
 
@@ -432,9 +424,7 @@ func resourceAlicloudSelectDBDbInstanceUpdate(d *schema.ResourceData, meta inter
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, defaultBeId)
 		}
-		d.SetPartial("cache_size")
 		if d.HasChange("db_instance_class") {
-			d.SetPartial("db_instance_class")
 		}
 	}
 
@@ -444,7 +434,6 @@ func resourceAlicloudSelectDBDbInstanceUpdate(d *schema.ResourceData, meta inter
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "ModifySelectDBInstanceDescription", AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("db_instance_description")
 	}
 
 	if d.HasChange("tags") {
@@ -452,7 +441,6 @@ func resourceAlicloudSelectDBDbInstanceUpdate(d *schema.ResourceData, meta inter
 		if err := selectDBService.SetResourceTags(d.Id(), added, removed); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 
 	if d.HasChange("desired_security_ip_lists") {
@@ -464,7 +452,6 @@ func resourceAlicloudSelectDBDbInstanceUpdate(d *schema.ResourceData, meta inter
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), "ModifySecurityIPList", AlibabaCloudSdkGoERROR)
 			}
 		}
-		d.SetPartial("desired_security_ip_lists")
 	}
 	stateConf := BuildStateConf([]string{"RESOURCE_PREPARING", "CREATING", "CLASS_CHANGING", "MODULE_UPGRADING", "NET_CREATING", "NET_DELETING"},
 		[]string{"ACTIVE"}, d.Timeout(schema.TimeoutUpdate), 10*time.Second, selectDBService.SelectDBDbInstanceStateRefreshFunc(d.Id(), []string{"DELETING"}))
@@ -7,9 +7,9 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlicloudSlbLoadBalancer() *schema.Resource {
@@ -27,11 +27,11 @@ func resourceAlicloudSlbLoadBalancer() *schema.Resource {
 		},
 		Schema: map[string]*schema.Schema{
 			"internet": {
-				Type:     schema.TypeBool,
-				Optional: true,
-				ForceNew: true,
-				Computed: true,
-				Removed:  "Field 'internet' has been removed from provider version 1.124. Use 'address_type' replaces it.",
+				Type:       schema.TypeBool,
+				Optional:   true,
+				ForceNew:   true,
+				Computed:   true,
+				Deprecated: "Field 'internet' has been removed from provider version 1.124. Use 'address_type' replaces it.",
 			},
 			"address": {
 				Type:     schema.TypeString,
@@ -394,7 +394,6 @@ func resourceAlicloudSlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 		if err := slbService.SetResourceTags(d, "instance"); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 	if d.HasChange("status") {
 		request := map[string]interface{}{
@@ -422,7 +421,6 @@ func resourceAlicloudSlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("status")
 	}
 	if !d.IsNewResource() && d.HasChange("delete_protection") {
 		request := map[string]interface{}{
@@ -451,7 +449,6 @@ func resourceAlicloudSlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("delete_protection")
 	}
 	update := false
 	request := map[string]interface{}{
@@ -487,8 +484,6 @@ func resourceAlicloudSlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("name")
-		d.SetPartial("load_balancer_name")
 	}
 	update = false
 	modifyLoadBalancerInstanceChargeTypeReq := map[string]interface{}{
@@ -521,7 +516,6 @@ func resourceAlicloudSlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("instance_charge_type")
 	}
 	update = false
 	modifyLoadBalancerInstanceSpecReq := map[string]interface{}{
@@ -557,8 +551,6 @@ func resourceAlicloudSlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("specification")
-		d.SetPartial("load_balancer_spec")
 	}
 	update = false
 	setLoadBalancerModificationProtectionReq := map[string]interface{}{
@@ -595,8 +587,6 @@ func resourceAlicloudSlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("modification_protection_status")
-		d.SetPartial("modification_protection_reason")
 	}
 	update = false
 	modifyLoadBalancerInternetSpecReq := map[string]interface{}{
@@ -633,8 +623,6 @@ func resourceAlicloudSlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("bandwidth")
-		d.SetPartial("internet_charge_type")
 	}
 	update = false
 	modifyLoadBalancerPayTypeReq := map[string]interface{}{
@@ -680,7 +668,6 @@ func resourceAlicloudSlbLoadBalancerUpdate(d *schema.ResourceData, meta interfac
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("payment_type")
 	}
 	d.Partial(false)
 	return resourceAlicloudSlbLoadBalancerRead(d, meta)
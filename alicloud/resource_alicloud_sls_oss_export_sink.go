@@ -11,9 +11,9 @@ import (
 	openapi "github.com/alibabacloud-go/darabonba-openapi/v2/client"
 	util "github.com/alibabacloud-go/tea-utils/v2/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAliCloudSlsOssExportSink() *schema.Resource {
@@ -62,7 +62,7 @@ func resourceAliCloudSlsOssExportSink() *schema.Resource {
 									"content_detail": {
 										Type:         schema.TypeString,
 										Required:     true,
-										ValidateFunc: validation.ValidateJsonString,
+										ValidateFunc: validation.StringIsJSON,
 										DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
 											equal, _ := compareJsonTemplateAreEquivalent(old, new)
 											return equal
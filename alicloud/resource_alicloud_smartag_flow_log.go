@@ -7,9 +7,9 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlicloudSmartagFlowLog() *schema.Resource {
@@ -279,17 +279,6 @@ func resourceAlicloudSmartagFlowLogUpdate(d *schema.ResourceData, meta interface
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("description")
-		d.SetPartial("flow_log_name")
-		d.SetPartial("inactive_aging")
-		d.SetPartial("logstore_name")
-		d.SetPartial("netflow_server_ip")
-		d.SetPartial("netflow_server_port")
-		d.SetPartial("netflow_version")
-		d.SetPartial("output_type")
-		d.SetPartial("project_name")
-		d.SetPartial("sls_region_id")
-		d.SetPartial("active_aging")
 	}
 	if d.HasChange("status") {
 
@@ -347,7 +336,6 @@ func resourceAlicloudSmartagFlowLogUpdate(d *schema.ResourceData, meta interface
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
 		}
-		d.SetPartial("status")
 	}
 	d.Partial(false)
 	return resourceAlicloudSmartagFlowLogRead(d, meta)
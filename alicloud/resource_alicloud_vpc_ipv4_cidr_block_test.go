@@ -11,12 +11,12 @@ import (
 	"github.com/alibabacloud-go/tea-rpc/client"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestAccAliCloudVPCIpv4CidrBlock_basic0(t *testing.T) {
@@ -77,7 +77,7 @@ data "alicloud_vpcs" "default" {
 }
 
 func TestUnitAccAlicloudVpcIpv4CidrBlock(t *testing.T) {
-	p := Provider().(*schema.Provider).ResourcesMap
+	p := Provider().ResourcesMap
 	dInit, _ := schema.InternalMap(p["alicloud_vpc_ipv4_cidr_block"].Schema).Data(nil, nil)
 	dExisted, _ := schema.InternalMap(p["alicloud_vpc_ipv4_cidr_block"].Schema).Data(nil, nil)
 	dInit.MarkNewResource()
@@ -9,8 +9,8 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudVpcPrefixList() *schema.Resource {
@@ -339,9 +339,6 @@ func resourceAlicloudVpcPrefixListUpdate(d *schema.ResourceData, meta interface{
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("prefix_list_name")
-		d.SetPartial("prefix_list_description")
-		d.SetPartial("max_entries")
 	}
 	update = false
 	action = "MoveResourceGroup"
@@ -380,7 +377,6 @@ func resourceAlicloudVpcPrefixListUpdate(d *schema.ResourceData, meta interface{
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("resource_group_id")
 	}
 
 	update = false
@@ -490,7 +486,6 @@ func resourceAlicloudVpcPrefixListUpdate(d *schema.ResourceData, meta interface{
 		if err := vpcServiceV2.SetResourceTags(d, "PrefixList"); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 	d.Partial(false)
 	return resourceAlicloudVpcPrefixListRead(d, meta)
@@ -8,8 +8,8 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudVpcTrafficMirrorFilter() *schema.Resource {
@@ -356,8 +356,6 @@ func resourceAliCloudVpcTrafficMirrorFilterUpdate(d *schema.ResourceData, meta i
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("traffic_mirror_filter_description")
-		d.SetPartial("traffic_mirror_filter_name")
 	}
 	update = false
 	action = "MoveResourceGroup"
@@ -393,7 +391,6 @@ func resourceAliCloudVpcTrafficMirrorFilterUpdate(d *schema.ResourceData, meta i
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("resource_group_id")
 	}
 
 	update = false
@@ -403,7 +400,6 @@ func resourceAliCloudVpcTrafficMirrorFilterUpdate(d *schema.ResourceData, meta i
 		if err := vpcServiceV2.SetResourceTags(d, "TRAFFICMIRRORFILTER"); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 	d.Partial(false)
 	return resourceAliCloudVpcTrafficMirrorFilterRead(d, meta)
@@ -9,8 +9,8 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudVpcVswitchCidrReservation() *schema.Resource {
@@ -234,8 +234,6 @@ func resourceAlicloudVpcVswitchCidrReservationUpdate(d *schema.ResourceData, met
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("cidr_reservation_description")
-		d.SetPartial("vswitch_cidr_reservation_name")
 	}
 
 	return resourceAlicloudVpcVswitchCidrReservationRead(d, meta)
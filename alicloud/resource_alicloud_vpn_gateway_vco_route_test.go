@@ -11,12 +11,12 @@ import (
 	"github.com/alibabacloud-go/tea-rpc/client"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/alibabacloud-go/tea/tea"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 func TestAccAlicloudVPNGatewayVcoRoute_basic0(t *testing.T) {
@@ -155,7 +155,7 @@ resource "alicloud_cen_transit_router_vpn_attachment" "default" {
 }
 
 func TestUnitAccAlicloudVpnGatewayVcoRoute(t *testing.T) {
-	p := Provider().(*schema.Provider).ResourcesMap
+	p := Provider().ResourcesMap
 	dInit, _ := schema.InternalMap(p["alicloud_vpn_gateway_vco_route"].Schema).Data(nil, nil)
 	dExisted, _ := schema.InternalMap(p["alicloud_vpn_gateway_vco_route"].Schema).Data(nil, nil)
 	dInit.MarkNewResource()
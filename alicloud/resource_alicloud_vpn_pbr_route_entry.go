@@ -7,11 +7,11 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlicloudVpnPbrRouteEntry() *schema.Resource {
@@ -193,7 +193,6 @@ func resourceAlicloudVpnPbrRouteEntryUpdate(d *schema.ResourceData, meta interfa
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, "alicloud_vpn_pbr_route_entry", action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("public_vpc")
 	}
 
 	weightRequest := map[string]interface{}{
@@ -232,7 +231,6 @@ func resourceAlicloudVpnPbrRouteEntryUpdate(d *schema.ResourceData, meta interfa
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, "alicloud_vpn_pbr_route_entry", action, AlibabaCloudSdkGoERROR)
 		}
-		d.SetPartial("weight")
 	}
 
 	d.Partial(false)
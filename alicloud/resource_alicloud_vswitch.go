@@ -12,8 +12,8 @@ import (
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAliCloudVpcVswitch() *schema.Resource {
@@ -25,6 +25,7 @@ func resourceAliCloudVpcVswitch() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: customizeDiffOnCreate(customizeDiffCidrWithinCidrs("cidr_block", customizeDiffVpcCidrBlocks("vpc_id"))),
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -332,8 +333,6 @@ func resourceAliCloudVpcVswitchUpdate(d *schema.ResourceData, meta interface{})
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		d.SetPartial("vswitch_name")
-		d.SetPartial("description")
 	}
 
 	update = false
@@ -343,7 +342,6 @@ func resourceAliCloudVpcVswitchUpdate(d *schema.ResourceData, meta interface{})
 		if err := vpcServiceV2.SetResourceTags(d, "VSWITCH"); err != nil {
 			return WrapError(err)
 		}
-		d.SetPartial("tags")
 	}
 	d.Partial(false)
 	return resourceAliCloudVpcVswitchRead(d, meta)
@@ -9,8 +9,8 @@ import (
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
@@ -644,7 +644,6 @@ func (s *AlikafkaService) setInstanceTags(d *schema.ResourceData, resourceType T
 			}
 		}
 
-		d.SetPartial("tags")
 	}
 
 	return nil
@@ -836,7 +835,6 @@ func (s *AlikafkaService) SetResourceTags(d *schema.ResourceData, resourceType s
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 			}
 		}
-		d.SetPartial("tags")
 	}
 	return nil
 }
@@ -0,0 +1,183 @@
+package alicloud
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/PaesslerAG/jsonpath"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	ApiStyleRpc = "RPC"
+	ApiStyleRoa = "ROA"
+)
+
+type ApiService struct {
+	client *connectivity.AliyunClient
+}
+
+// ApiAction is an API call of the generic api request data source and resource.
+type ApiAction struct {
+	Product  string
+	Version  string
+	Style    string
+	Action   string
+	Method   string
+	Pathname string
+	Query    map[string]interface{}
+	Body     string
+}
+
+func apiActionSchema(required bool) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: required,
+		Optional: !required,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"action": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"method": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "POST",
+					ValidateFunc: StringInSlice([]string{"GET", "POST", "PUT", "DELETE", "PATCH"}, false),
+				},
+				"pathname": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"query": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"body": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateFunc:     validation.StringIsJSON,
+					DiffSuppressFunc: apiBodyDiffSuppressFunc,
+				},
+			},
+		},
+	}
+}
+
+func apiBodyDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return old == new
+	}
+	var oldObject, newObject interface{}
+	if json.Unmarshal([]byte(old), &oldObject) != nil || json.Unmarshal([]byte(new), &newObject) != nil {
+		return false
+	}
+	return convertObjectToJsonString(oldObject) == convertObjectToJsonString(newObject)
+}
+
+// expandApiAction returns the api action of the block. The placeholder ${id} in the pathname, query and body
+// is replaced by the id, and the placeholder ${region_id} is replaced by the region of the provider.
+func (s *ApiService) expandApiAction(d *schema.ResourceData, key, id string) (*ApiAction, bool) {
+	v, ok := d.GetOk(key)
+	if !ok || len(v.([]interface{})) == 0 || v.([]interface{})[0] == nil {
+		return nil, false
+	}
+	replacer := strings.NewReplacer("${id}", id, "${region_id}", s.client.RegionId)
+	block := v.([]interface{})[0].(map[string]interface{})
+	action := &ApiAction{
+		Product:  d.Get("product").(string),
+		Version:  d.Get("version").(string),
+		Style:    d.Get("style").(string),
+		Action:   block["action"].(string),
+		Method:   block["method"].(string),
+		Pathname: replacer.Replace(block["pathname"].(string)),
+		Query:    make(map[string]interface{}),
+		Body:     replacer.Replace(block["body"].(string)),
+	}
+	for k, v := range block["query"].(map[string]interface{}) {
+		action.Query[k] = replacer.Replace(fmt.Sprint(v))
+	}
+	return action, true
+}
+
+// DoApiAction sends the api action. The response of the ROA api is the body of the response.
+func (s *ApiService) DoApiAction(action *ApiAction) (map[string]interface{}, error) {
+	var body map[string]interface{}
+	if action.Body != "" {
+		if err := json.Unmarshal([]byte(action.Body), &body); err != nil {
+			return nil, WrapError(err)
+		}
+	}
+	if action.Style == ApiStyleRoa {
+		query := make(map[string]*string)
+		for k, v := range action.Query {
+			query[k] = StringPointer(fmt.Sprint(v))
+		}
+		var requestBody interface{}
+		if body != nil {
+			requestBody = body
+		}
+		response, err := s.client.RoaRequest(action.Product, action.Version, action.Action, action.Method, action.Pathname, query, requestBody)
+		addDebug(action.Action, response, action.Query, body)
+		if err != nil {
+			return nil, err
+		}
+		result := make(map[string]interface{})
+		if v, ok := response["body"].(map[string]interface{}); ok {
+			result = v
+		}
+		return result, nil
+	}
+
+	query := make(map[string]interface{})
+	for k, v := range action.Query {
+		query[k] = v
+	}
+	if _, ok := query["RegionId"]; !ok {
+		query["RegionId"] = s.client.RegionId
+	}
+	var response map[string]interface{}
+	var err error
+	if action.Method == "GET" {
+		response, err = s.client.RpcGet(action.Product, action.Version, action.Action, query, body)
+	} else {
+		response, err = s.client.RpcPost(action.Product, action.Version, action.Action, query, body, true)
+	}
+	addDebug(action.Action, response, query, body)
+	return response, err
+}
+
+// apiResults extracts the values of the response by the JSONPath expressions. The values which are not strings
+// are encoded as json.
+func apiResults(response map[string]interface{}, paths map[string]interface{}) (map[string]interface{}, error) {
+	results := make(map[string]interface{})
+	for name, path := range paths {
+		v, err := jsonpath.Get(path.(string), response)
+		if err != nil {
+			return nil, WrapErrorf(err, FailedGetAttributeMsg, name, path, response)
+		}
+		results[name] = apiResultString(v)
+	}
+	return results, nil
+}
+
+func apiResultString(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case json.Number, bool, int, int64:
+		return fmt.Sprint(value)
+	}
+	return convertObjectToJsonString(v)
+}
@@ -6,8 +6,9 @@ import (
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
+	"github.com/alibabacloud-go/tea/tea"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 type BssOpenApiService struct {
@@ -335,3 +336,44 @@ func (s *BssOpenApiService) QueryAvailableInstanceList(instanceRegion, productCo
 	}
 	return v.([]interface{}), nil
 }
+
+// GetPrice calls the price api, like GetPayAsYouGoPrice and GetSubscriptionPrice, and returns the Data of the response.
+// The international site endpoint is used if the product is not applicable on the domestic site.
+func (s *BssOpenApiService) GetPrice(action string, request map[string]interface{}) (object map[string]interface{}, err error) {
+	var response map[string]interface{}
+	conn, err := s.client.NewBssopenapiClient()
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	runtime := util.RuntimeOptions{}
+	runtime.SetAutoretry(true)
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-12-14"), StringPointer("AK"), nil, request, &runtime)
+		if err != nil {
+			if NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			if IsExpectedErrors(err, []string{"NotApplicable"}) && tea.StringValue(conn.Endpoint) != connectivity.BssOpenAPIEndpointInternational {
+				conn.Endpoint = String(connectivity.BssOpenAPIEndpointInternational)
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	if err != nil {
+		return object, WrapErrorf(err, DefaultErrorMsg, request["ProductCode"], action, AlibabaCloudSdkGoERROR)
+	}
+	if fmt.Sprint(response["Success"]) != "true" {
+		return object, WrapError(fmt.Errorf("%s failed, response: %v", action, response))
+	}
+	v, err := jsonpath.Get("$.Data", response)
+	if err != nil {
+		return object, WrapErrorf(err, FailedGetAttributeMsg, request["ProductCode"], "$.Data", response)
+	}
+	object, _ = v.(map[string]interface{})
+	return object, nil
+}
@@ -7,11 +7,11 @@ import (
 	"strings"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/alibabacloud-go/tea/tea"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"encoding/base64"
 
@@ -269,12 +269,18 @@ func (s *CsService) DescribeClusterKubeConfig(clusterId string, isResource bool)
 // DescribeClusterKubeConfigWithExpiration return cluster kube_config credential with expiration time.
 // It's used for kubernetes/managed_kubernetes/serverless_kubernetes.
 func (s *CsClient) DescribeClusterKubeConfigWithExpiration(clusterId string, temporaryDurationMinutes int64) (*client.DescribeClusterUserKubeconfigResponseBody, error) {
+	return s.DescribeClusterKubeConfigWithEndpoint(clusterId, temporaryDurationMinutes, false)
+}
+
+// DescribeClusterKubeConfigWithEndpoint return cluster kube_config credential with expiration time,
+// and the server of the kube_config is the internal endpoint of the API server when privateIpAddress is true.
+func (s *CsClient) DescribeClusterKubeConfigWithEndpoint(clusterId string, temporaryDurationMinutes int64, privateIpAddress bool) (*client.DescribeClusterUserKubeconfigResponseBody, error) {
 	if clusterId == "" {
 		return nil, WrapError(fmt.Errorf("clusterid is empty"))
 	}
 
 	request := &client.DescribeClusterUserKubeconfigRequest{
-		PrivateIpAddress: tea.Bool(false),
+		PrivateIpAddress: tea.Bool(privateIpAddress),
 	}
 	if temporaryDurationMinutes > 0 {
 		request.TemporaryDurationMinutes = tea.Int64(temporaryDurationMinutes)
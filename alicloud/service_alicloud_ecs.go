@@ -17,8 +17,8 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type EcsService struct {
@@ -787,7 +787,6 @@ func (s *EcsService) updateImage(d *schema.ResourceData) error {
 	if err != nil {
 		return WrapError(err)
 	} else {
-		d.SetPartial("tags")
 	}
 
 	request := ecs.CreateModifyImageAttributeRequest()
@@ -814,9 +813,6 @@ func (s *EcsService) updateImage(d *schema.ResourceData) error {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabaCloudSdkGoERROR)
 		}
 
-		d.SetPartial("name")
-		d.SetPartial("image_name")
-		d.SetPartial("description")
 	}
 
 	d.Partial(false)
@@ -1621,7 +1617,6 @@ func (s *EcsService) SetResourceTags(d *schema.ResourceData, resourceType string
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 			}
 		}
-		d.SetPartial("tags")
 	}
 	return nil
 }
@@ -1867,6 +1862,7 @@ func (s *EcsService) DescribeLaunchTemplateVersions(id string) (object []interfa
 	request := map[string]interface{}{
 		"RegionId":         s.client.RegionId,
 		"LaunchTemplateId": id,
+		"PageSize":         PageSizeLarge,
 	}
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
@@ -1970,7 +1966,6 @@ func (s *EcsService) SetResourceTemplateTags(d *schema.ResourceData, resourceTyp
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 			}
 		}
-		d.SetPartial("template_tags")
 	}
 	return nil
 }
@@ -2009,6 +2004,148 @@ func (s *EcsService) DescribeEcsLaunchTemplate(id string) (object map[string]int
 	return object, nil
 }
 
+func (s *EcsService) DescribeEcsLaunchTemplateVersion(id string) (object map[string]interface{}, err error) {
+	var response map[string]interface{}
+	conn, err := s.client.NewEcsClient()
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	action := "DescribeLaunchTemplateVersions"
+	request := map[string]interface{}{
+		"RegionId":                s.client.RegionId,
+		"LaunchTemplateId":        parts[0],
+		"LaunchTemplateVersion.1": parts[1],
+		"DetailFlag":              true,
+	}
+	runtime := util.RuntimeOptions{}
+	runtime.SetAutoretry(true)
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &runtime)
+		if err != nil {
+			if NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	if err != nil {
+		if IsExpectedErrors(err, []string{"InvalidLaunchTemplate.NotFound", "InvalidLaunchTemplateVersion.NotFound"}) {
+			return object, WrapErrorf(Error(GetNotFoundMessage("Ecs:LaunchTemplateVersion", id)), NotFoundMsg, ProviderERROR)
+		}
+		return object, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabaCloudSdkGoERROR)
+	}
+	v, err := jsonpath.Get("$.LaunchTemplateVersionSets.LaunchTemplateVersionSet", response)
+	if err != nil {
+		return object, WrapErrorf(err, FailedGetAttributeMsg, id, "$.LaunchTemplateVersionSets.LaunchTemplateVersionSet", response)
+	}
+	for _, version := range v.([]interface{}) {
+		if item, ok := version.(map[string]interface{}); ok && fmt.Sprint(item["LaunchTemplateId"]) == parts[0] && fmt.Sprint(formatInt(item["VersionNumber"])) == parts[1] {
+			return item, nil
+		}
+	}
+	return object, WrapErrorf(Error(GetNotFoundMessage("Ecs:LaunchTemplateVersion", id)), NotFoundWithResponse, response)
+}
+
+func (s *EcsService) ModifyEcsLaunchTemplateDefaultVersion(id string, version int) error {
+	var response map[string]interface{}
+	conn, err := s.client.NewEcsClient()
+	if err != nil {
+		return WrapError(err)
+	}
+	action := "ModifyLaunchTemplateDefaultVersion"
+	request := map[string]interface{}{
+		"RegionId":             s.client.RegionId,
+		"LaunchTemplateId":     id,
+		"DefaultVersionNumber": version,
+	}
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+		if err != nil {
+			if NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, id, action, AlibabaCloudSdkGoERROR)
+	}
+	return nil
+}
+
+// PruneEcsLaunchTemplateVersions deletes the versions of the launch template except the latest retained ones and the default one.
+func (s *EcsService) PruneEcsLaunchTemplateVersions(id string, retained int) error {
+	versions, err := s.DescribeLaunchTemplateVersions(id)
+	if err != nil {
+		return WrapError(err)
+	}
+	numbers := make([]int, 0, len(versions))
+	defaultVersion := 0
+	for _, version := range versions {
+		item, ok := version.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		numbers = append(numbers, formatInt(item["VersionNumber"]))
+		if formatBool(item["DefaultVersion"]) {
+			defaultVersion = formatInt(item["VersionNumber"])
+		}
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(numbers)))
+
+	request := map[string]interface{}{
+		"RegionId":         s.client.RegionId,
+		"LaunchTemplateId": id,
+	}
+	count := 0
+	for i, number := range numbers {
+		if i < retained || number == defaultVersion {
+			continue
+		}
+		count++
+		request[fmt.Sprintf("DeleteVersion.%d", count)] = number
+	}
+	if count == 0 {
+		return nil
+	}
+
+	var response map[string]interface{}
+	conn, err := s.client.NewEcsClient()
+	if err != nil {
+		return WrapError(err)
+	}
+	action := "DeleteLaunchTemplateVersion"
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+		if err != nil {
+			if NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, id, action, AlibabaCloudSdkGoERROR)
+	}
+	return nil
+}
+
 func (s *EcsService) DescribeEcsSnapshot(id string) (object map[string]interface{}, err error) {
 	var response map[string]interface{}
 	action := "DescribeSnapshots"
@@ -2869,6 +3006,58 @@ func (s *EcsService) EcsInvocationStateRefreshFunc(id string, failStates []strin
 	}
 }
 
+// DescribeEcsInvocationResults returns the latest execution result of the invocation on every targeted instance.
+func (s *EcsService) DescribeEcsInvocationResults(id string) (objects []map[string]interface{}, err error) {
+	var response map[string]interface{}
+	conn, err := s.client.NewEcsClient()
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	action := "DescribeInvocationResults"
+	request := map[string]interface{}{
+		"RegionId":        s.client.RegionId,
+		"InvokeId":        id,
+		"ContentEncoding": "PlainText",
+		"PageSize":        PageSizeLarge,
+		"PageNumber":      1,
+	}
+	for {
+		runtime := util.RuntimeOptions{}
+		runtime.SetAutoretry(true)
+		wait := incrementalWait(3*time.Second, 3*time.Second)
+		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &runtime)
+			if err != nil {
+				if NeedRetry(err) {
+					wait()
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			return nil
+		})
+		addDebug(action, response, request)
+		if err != nil {
+			return objects, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabaCloudSdkGoERROR)
+		}
+		v, err := jsonpath.Get("$.Invocation.InvocationResults.InvocationResult", response)
+		if err != nil {
+			return objects, WrapErrorf(err, FailedGetAttributeMsg, id, "$.Invocation.InvocationResults.InvocationResult", response)
+		}
+		result, _ := v.([]interface{})
+		for _, item := range result {
+			if object, ok := item.(map[string]interface{}); ok {
+				objects = append(objects, object)
+			}
+		}
+		if len(result) < request["PageSize"].(int) {
+			break
+		}
+		request["PageNumber"] = request["PageNumber"].(int) + 1
+	}
+	return objects, nil
+}
+
 func (s *EcsService) DescribeEcsSystemDisk(id string) (object map[string]interface{}, err error) {
 	var response map[string]interface{}
 	conn, err := s.client.NewEcsClient()
@@ -3000,7 +3189,6 @@ func (s *EcsService) SetInstanceSetResourceTags(d *schema.ResourceData, resource
 					return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabaCloudSdkGoERROR)
 				}
 			}
-			d.SetPartial("tags")
 		}
 	}
 	return nil
@@ -10,12 +10,12 @@ import (
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 type EssService struct {
@@ -1012,3 +1012,186 @@ func (s *EssService) ListTagResources(scalingGroupId string, client *connectivit
 	}
 	return tags, nil
 }
+
+// StartEssInstanceRefresh starts the instance refresh of the scaling group, which replaces the instances by the ones of the
+// desired configuration in batches, and returns the id of the instance refresh task.
+func (s *EssService) StartEssInstanceRefresh(scalingGroupId string, desiredConfiguration map[string]interface{}, instanceRefresh map[string]interface{}) (string, error) {
+	var response map[string]interface{}
+	conn, err := s.client.NewEssClient()
+	if err != nil {
+		return "", WrapError(err)
+	}
+	action := "StartInstanceRefresh"
+	request := map[string]interface{}{
+		"RegionId":       s.client.RegionId,
+		"ScalingGroupId": scalingGroupId,
+		"ClientToken":    buildClientToken(action),
+	}
+	for key, value := range desiredConfiguration {
+		request["DesiredConfiguration."+key] = value
+	}
+	if v, ok := instanceRefresh["min_healthy_percentage"].(int); ok {
+		request["MinHealthyPercentage"] = v
+	}
+	if v, ok := instanceRefresh["max_healthy_percentage"].(int); ok && v > 0 {
+		request["MaxHealthyPercentage"] = v
+	}
+	if v, ok := instanceRefresh["checkpoints"].([]interface{}); ok {
+		for i, percentage := range v {
+			request[fmt.Sprintf("Checkpoints.%d.Percentage", i+1)] = percentage
+		}
+	}
+	if v, ok := instanceRefresh["checkpoint_pause_time"].(int); ok && v > 0 {
+		request["CheckpointPauseTime"] = v
+	}
+	if v, ok := instanceRefresh["skip_matching"].(bool); ok {
+		request["SkipMatching"] = v
+	}
+	runtime := util.RuntimeOptions{}
+	runtime.SetAutoretry(true)
+	wait := incrementalWait(3*time.Second, 5*time.Second)
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-08-28"), StringPointer("AK"), nil, request, &runtime)
+		if err != nil {
+			if NeedRetry(err) || IsExpectedErrors(err, []string{"IncorrectScalingGroupStatus", "ScalingActivityInProgress"}) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	if err != nil {
+		return "", WrapErrorf(err, DefaultErrorMsg, scalingGroupId, action, AlibabaCloudSdkGoERROR)
+	}
+	v, err := jsonpath.Get("$.InstanceRefreshTaskId", response)
+	if err != nil {
+		return "", WrapErrorf(err, FailedGetAttributeMsg, scalingGroupId, "$.InstanceRefreshTaskId", response)
+	}
+	return fmt.Sprint(v), nil
+}
+
+func (s *EssService) DescribeEssInstanceRefresh(scalingGroupId, taskId string) (object map[string]interface{}, err error) {
+	var response map[string]interface{}
+	conn, err := s.client.NewEssClient()
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	action := "DescribeInstanceRefreshes"
+	request := map[string]interface{}{
+		"RegionId":                 s.client.RegionId,
+		"ScalingGroupId":           scalingGroupId,
+		"InstanceRefreshTaskIds.1": taskId,
+	}
+	runtime := util.RuntimeOptions{}
+	runtime.SetAutoretry(true)
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-08-28"), StringPointer("AK"), nil, request, &runtime)
+		if err != nil {
+			if NeedRetry(err) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	if err != nil {
+		return object, WrapErrorf(err, DefaultErrorMsg, taskId, action, AlibabaCloudSdkGoERROR)
+	}
+	v, err := jsonpath.Get("$.InstanceRefreshTasks", response)
+	if err != nil {
+		return object, WrapErrorf(err, FailedGetAttributeMsg, taskId, "$.InstanceRefreshTasks", response)
+	}
+	if tasks, ok := v.([]interface{}); ok {
+		for _, task := range tasks {
+			if item, ok := task.(map[string]interface{}); ok && fmt.Sprint(item["InstanceRefreshTaskId"]) == taskId {
+				return item, nil
+			}
+		}
+	}
+	return object, WrapErrorf(Error(GetNotFoundMessage("Ess:InstanceRefresh", taskId)), NotFoundMsg, ProviderERROR)
+}
+
+// EssInstanceRefreshStateRefreshFunc fails with the detail of the instance refresh task, like the reason of the rollback.
+func (s *EssService) EssInstanceRefreshStateRefreshFunc(scalingGroupId, taskId string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeEssInstanceRefresh(scalingGroupId, taskId)
+		if err != nil {
+			if NotFoundError(err) {
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+		status := fmt.Sprint(object["Status"])
+		for _, failState := range failStates {
+			if status == failState {
+				return object, status, WrapError(Error("the instance refresh %s of the scaling group %s is %s: %v", taskId, scalingGroupId, status, object["Detail"]))
+			}
+		}
+		return object, status, nil
+	}
+}
+
+// RefreshEssInstances starts the instance refresh of the scaling group and waits for it to be successful.
+func (s *EssService) RefreshEssInstances(scalingGroupId string, desiredConfiguration map[string]interface{}, instanceRefresh map[string]interface{}, timeout time.Duration) error {
+	taskId, err := s.StartEssInstanceRefresh(scalingGroupId, desiredConfiguration, instanceRefresh)
+	if err != nil {
+		return err
+	}
+	stateConf := BuildStateConf([]string{}, []string{"Successful"}, timeout, 30*time.Second, s.EssInstanceRefreshStateRefreshFunc(scalingGroupId, taskId, []string{"Failed", "Cancelled", "RollbackSuccessful", "RollbackFailed"}))
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, IdMsg, scalingGroupId)
+	}
+	return nil
+}
+
+func essInstanceRefreshSchema(withTriggers bool) *schema.Schema {
+	s := map[string]*schema.Schema{
+		"min_healthy_percentage": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      100,
+			ValidateFunc: IntBetween(0, 100),
+		},
+		"max_healthy_percentage": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: IntBetween(100, 200),
+		},
+		"checkpoints": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeInt,
+				ValidateFunc: IntBetween(1, 100),
+			},
+		},
+		"checkpoint_pause_time": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ValidateFunc: IntBetween(1, 2880),
+		},
+		"skip_matching": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	}
+	if withTriggers {
+		s["triggers"] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}
+	}
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem:     &schema.Resource{Schema: s},
+	}
+}
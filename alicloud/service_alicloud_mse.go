@@ -3,13 +3,13 @@ package alicloud
 import (
 	"fmt"
 	rpc "github.com/alibabacloud-go/tea-rpc/client"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"time"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
 type MseService struct {
@@ -179,7 +179,6 @@ func (s *MseService) SetResourceTags(d *schema.ResourceData, resourceType string
 			}
 
 		}
-		d.SetPartial("tags")
 	}
 
 	return nil
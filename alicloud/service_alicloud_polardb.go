@@ -17,8 +17,8 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/polardb"
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type PolarDBService struct {
@@ -984,7 +984,6 @@ func (s *PolarDBService) ModifyParameters(d *schema.ResourceData) error {
 			return WrapError(err)
 		}
 	}
-	d.SetPartial("parameters")
 	return nil
 }
 
@@ -1028,9 +1027,6 @@ func (s *PolarDBService) CreateClusterParamsModifyParameters(d *schema.ResourceD
 	if err := s.WaitForPolarDBParameter(d.Id(), 1200, allConfig); err != nil {
 		return WrapError(err)
 	}
-	for _, i := range changeParams {
-		d.SetPartial(i)
-	}
 	return nil
 }
 
@@ -1075,7 +1071,6 @@ func (s *PolarDBService) setClusterTags(d *schema.ResourceData) error {
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		}
 
-		d.SetPartial("tags")
 	}
 
 	return nil
@@ -1693,7 +1688,6 @@ func (s *PolarDBService) ModifyDBClusterAccessWhitelist(d *schema.ResourceData)
 				return WrapError(err)
 			}
 		}
-		d.SetPartial("db_cluster_ip_array")
 	}
 	return nil
 }
@@ -8,8 +8,8 @@ import (
 	"github.com/PaesslerAG/jsonpath"
 	rpc "github.com/alibabacloud-go/tea-rpc/client"
 	util "github.com/alibabacloud-go/tea-utils/service"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"time"
 
@@ -581,7 +581,6 @@ func (s *PvtzService) SetResourceTags(d *schema.ResourceData, resourceType strin
 			}
 
 		}
-		d.SetPartial("tags")
 	}
 
 	return nil
@@ -0,0 +1,195 @@
+package alicloud
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+// SweepCandidate is an existing resource which may be swept.
+type SweepCandidate struct {
+	Type            string            `json:"type"`
+	Region          string            `json:"region"`
+	Id              string            `json:"id"`
+	Name            string            `json:"name,omitempty"`
+	ResourceGroupId string            `json:"resource_group_id,omitempty"`
+	Tags            map[string]string `json:"tags,omitempty"`
+	CreationTime    time.Time         `json:"creation_time,omitempty"`
+}
+
+// Sweeper lists and deletes the resources of a type. The sweepers of the dependencies are run before it,
+// like the sweepers registered by resource.AddTestSweepers.
+type Sweeper struct {
+	Type         string
+	Dependencies []string
+	List         func(client *connectivity.AliyunClient) ([]SweepCandidate, error)
+	Delete       func(client *connectivity.AliyunClient, candidate SweepCandidate) error
+}
+
+var sweepers sync.Map
+
+// AddSweeper registers the sweeper of the resource type.
+func AddSweeper(sweeper *Sweeper) {
+	if _, loaded := sweepers.LoadOrStore(sweeper.Type, sweeper); loaded {
+		log.Fatalf("[ERROR] the sweeper of %s has been registered", sweeper.Type)
+	}
+}
+
+// SweepFilter selects the candidates to sweep. A candidate is swept only if it matches all of the set conditions.
+type SweepFilter struct {
+	NamePrefixes    []string
+	Tags            map[string]string
+	OlderThan       time.Duration
+	ResourceGroupId string
+}
+
+func (f SweepFilter) IsEmpty() bool {
+	return len(f.NamePrefixes) == 0 && len(f.Tags) == 0 && f.OlderThan <= 0 && f.ResourceGroupId == ""
+}
+
+// Match returns whether the candidate matches the filter, and the reason if it does not.
+func (f SweepFilter) Match(candidate SweepCandidate, now time.Time) (bool, string) {
+	if len(f.NamePrefixes) > 0 {
+		matched := false
+		for _, prefix := range f.NamePrefixes {
+			if strings.HasPrefix(strings.ToLower(candidate.Name), strings.ToLower(prefix)) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, "the name does not have the prefixes"
+		}
+	}
+	for key, value := range f.Tags {
+		if v, ok := candidate.Tags[key]; !ok || (value != "" && v != value) {
+			return false, fmt.Sprintf("the tag %s does not match", key)
+		}
+	}
+	if f.OlderThan > 0 && (candidate.CreationTime.IsZero() || now.Sub(candidate.CreationTime) < f.OlderThan) {
+		return false, "the resource is not old enough"
+	}
+	if f.ResourceGroupId != "" && candidate.ResourceGroupId != f.ResourceGroupId {
+		return false, "the resource group does not match"
+	}
+	return true, ""
+}
+
+// SweepOptions are the options of Sweep.
+type SweepOptions struct {
+	// Types are the resource types to sweep. The sweepers of their dependencies are run as well.
+	// Default to all of the registered sweepers.
+	Types  []string
+	Filter SweepFilter
+	// DryRun only reports the resources which would be deleted.
+	DryRun bool
+}
+
+const (
+	SweepStatusWouldDelete = "would_delete"
+	SweepStatusDeleted     = "deleted"
+	SweepStatusFailed      = "failed"
+)
+
+// SweepReportItem is a swept resource in the report.
+type SweepReportItem struct {
+	SweepCandidate
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// SweepReport is the result of Sweep, which is written as json.
+type SweepReport struct {
+	DryRun bool              `json:"dry_run"`
+	Items  []SweepReportItem `json:"items"`
+}
+
+// Sweep deletes the resources matching the filter in the region of the client, in the order of the dependencies.
+// An empty filter is rejected, so that all of the resources of an account are never deleted by mistake.
+func Sweep(client *connectivity.AliyunClient, opts SweepOptions) (*SweepReport, error) {
+	if opts.Filter.IsEmpty() {
+		return nil, fmt.Errorf("at least one of the name prefixes, tags, age and resource group is required to sweep")
+	}
+	ordered, err := sortedSweepers(opts.Types)
+	if err != nil {
+		return nil, err
+	}
+	report := &SweepReport{DryRun: opts.DryRun, Items: []SweepReportItem{}}
+	now := time.Now()
+	for _, sweeper := range ordered {
+		candidates, err := sweeper.List(client)
+		if err != nil {
+			return report, WrapErrorf(err, "listing the %s in the region %s failed", sweeper.Type, client.RegionId)
+		}
+		for _, candidate := range candidates {
+			candidate.Type = sweeper.Type
+			candidate.Region = client.RegionId
+			if ok, reason := opts.Filter.Match(candidate, now); !ok {
+				log.Printf("[DEBUG] skipping the %s %s (%s): %s", sweeper.Type, candidate.Id, candidate.Name, reason)
+				continue
+			}
+			item := SweepReportItem{SweepCandidate: candidate, Status: SweepStatusWouldDelete}
+			if !opts.DryRun {
+				log.Printf("[INFO] deleting the %s %s (%s)", sweeper.Type, candidate.Id, candidate.Name)
+				if err := sweeper.Delete(client, candidate); err != nil && !NotFoundError(err) {
+					item.Status = SweepStatusFailed
+					item.Error = err.Error()
+					log.Printf("[ERROR] deleting the %s %s (%s) got an error: %v", sweeper.Type, candidate.Id, candidate.Name, err)
+				} else {
+					item.Status = SweepStatusDeleted
+				}
+			}
+			report.Items = append(report.Items, item)
+		}
+	}
+	return report, nil
+}
+
+// sortedSweepers returns the sweepers of the types and their dependencies, where every sweeper is after its dependencies.
+func sortedSweepers(types []string) ([]*Sweeper, error) {
+	if len(types) == 0 {
+		sweepers.Range(func(key, value interface{}) bool {
+			types = append(types, key.(string))
+			return true
+		})
+	}
+	sort.Strings(types)
+	var ordered []*Sweeper
+	visited := make(map[string]bool)
+	visiting := make(map[string]bool)
+	var visit func(resourceType string) error
+	visit = func(resourceType string) error {
+		if visited[resourceType] {
+			return nil
+		}
+		if visiting[resourceType] {
+			return fmt.Errorf("the sweepers have a dependency cycle at %s", resourceType)
+		}
+		v, ok := sweepers.Load(resourceType)
+		if !ok {
+			return fmt.Errorf("the sweeper of %s is not found", resourceType)
+		}
+		sweeper := v.(*Sweeper)
+		visiting[resourceType] = true
+		for _, dependency := range sweeper.Dependencies {
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		visiting[resourceType] = false
+		visited[resourceType] = true
+		ordered = append(ordered, sweeper)
+		return nil
+	}
+	for _, resourceType := range types {
+		if err := visit(resourceType); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
@@ -0,0 +1,124 @@
+package alicloud
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/PaesslerAG/jsonpath"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+)
+
+func init() {
+	AddSweeper(&Sweeper{
+		Type:   "alicloud_instance",
+		List:   sweepCandidateLister("Ecs", "2014-05-26", "DescribeInstances", "$.Instances.Instance[*]", "InstanceId", "InstanceName"),
+		Delete: sweepDeleter("Ecs", "2014-05-26", "DeleteInstance", "InstanceId", map[string]interface{}{"Force": true}),
+	})
+	AddSweeper(&Sweeper{
+		Type:         "alicloud_security_group",
+		Dependencies: []string{"alicloud_instance"},
+		List:         sweepCandidateLister("Ecs", "2014-05-26", "DescribeSecurityGroups", "$.SecurityGroups.SecurityGroup[*]", "SecurityGroupId", "SecurityGroupName"),
+		Delete:       sweepDeleter("Ecs", "2014-05-26", "DeleteSecurityGroup", "SecurityGroupId", nil),
+	})
+	AddSweeper(&Sweeper{
+		Type:         "alicloud_vswitch",
+		Dependencies: []string{"alicloud_instance"},
+		List:         sweepCandidateLister("Vpc", "2016-04-28", "DescribeVSwitches", "$.VSwitches.VSwitch[*]", "VSwitchId", "VSwitchName"),
+		Delete:       sweepDeleter("Vpc", "2016-04-28", "DeleteVSwitch", "VSwitchId", nil),
+	})
+	AddSweeper(&Sweeper{
+		Type:         "alicloud_vpc",
+		Dependencies: []string{"alicloud_vswitch", "alicloud_security_group"},
+		List:         sweepCandidateLister("Vpc", "2016-04-28", "DescribeVpcs", "$.Vpcs.Vpc[*]", "VpcId", "VpcName"),
+		Delete:       sweepDeleter("Vpc", "2016-04-28", "DeleteVpc", "VpcId", nil),
+	})
+}
+
+// sweepCandidateLister returns the lister of the paged Describe api, whose items have the creation time,
+// resource group and tags in the common format.
+func sweepCandidateLister(product, version, action, itemsPath, idKey, nameKey string) func(client *connectivity.AliyunClient) ([]SweepCandidate, error) {
+	return func(client *connectivity.AliyunClient) ([]SweepCandidate, error) {
+		var candidates []SweepCandidate
+		request := map[string]interface{}{
+			"RegionId":   client.RegionId,
+			"PageSize":   PageSizeLarge,
+			"PageNumber": 1,
+		}
+		for {
+			response, err := client.RpcPost(product, version, action, request, nil, true)
+			if err != nil {
+				return candidates, WrapErrorf(err, DataDefaultErrorMsg, "sweeper", action, AlibabaCloudSdkGoERROR)
+			}
+			v, err := jsonpath.Get(itemsPath, response)
+			if err != nil {
+				return candidates, WrapErrorf(err, FailedGetAttributeMsg, action, itemsPath, response)
+			}
+			items, _ := v.([]interface{})
+			for _, item := range items {
+				object, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				candidates = append(candidates, sweepCandidateOf(object, idKey, nameKey))
+			}
+			if len(items) < PageSizeLarge {
+				break
+			}
+			request["PageNumber"] = request["PageNumber"].(int) + 1
+		}
+		return candidates, nil
+	}
+}
+
+func sweepCandidateOf(object map[string]interface{}, idKey, nameKey string) SweepCandidate {
+	candidate := SweepCandidate{
+		Id:   fmt.Sprint(object[idKey]),
+		Tags: make(map[string]string),
+	}
+	if v, ok := object[nameKey].(string); ok {
+		candidate.Name = v
+	}
+	if v, ok := object["ResourceGroupId"].(string); ok {
+		candidate.ResourceGroupId = v
+	}
+	if v, ok := object["CreationTime"].(string); ok {
+		for _, layout := range []string{time.RFC3339, "2006-01-02T15:04Z"} {
+			if t, err := time.Parse(layout, v); err == nil {
+				candidate.CreationTime = t
+				break
+			}
+		}
+	}
+	if tags, err := jsonpath.Get("$.Tags.Tag[*]", object); err == nil {
+		for _, tag := range tags.([]interface{}) {
+			if t, ok := tag.(map[string]interface{}); ok {
+				key, value := t["Key"], t["Value"]
+				if key == nil {
+					key, value = t["TagKey"], t["TagValue"]
+				}
+				if key != nil {
+					candidate.Tags[fmt.Sprint(key)] = fmt.Sprint(value)
+				}
+			}
+		}
+	}
+	return candidate
+}
+
+func sweepDeleter(product, version, action, idKey string, extra map[string]interface{}) func(client *connectivity.AliyunClient, candidate SweepCandidate) error {
+	return func(client *connectivity.AliyunClient, candidate SweepCandidate) error {
+		request := map[string]interface{}{
+			"RegionId": client.RegionId,
+			idKey:      candidate.Id,
+		}
+		for k, v := range extra {
+			request[k] = v
+		}
+		response, err := client.RpcPost(product, version, action, request, nil, false)
+		addDebug(action, response, request)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, candidate.Id, action, AlibabaCloudSdkGoERROR)
+		}
+		return nil
+	}
+}
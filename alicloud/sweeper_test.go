@@ -0,0 +1,80 @@
+package alicloud
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitAliCloudSweepFilter(t *testing.T) {
+	now := time.Now()
+	candidate := SweepCandidate{
+		Id:              "vpc-abc",
+		Name:            "ci-vpc",
+		ResourceGroupId: "rg-abc",
+		Tags:            map[string]string{"owner": "ci", "temporary": ""},
+		CreationTime:    now.Add(-48 * time.Hour),
+	}
+	assert.True(t, SweepFilter{}.IsEmpty())
+
+	cases := []struct {
+		filter   SweepFilter
+		expected bool
+	}{
+		{SweepFilter{NamePrefixes: []string{"tf-testAcc", "CI-"}}, true},
+		{SweepFilter{NamePrefixes: []string{"tf-testAcc"}}, false},
+		{SweepFilter{Tags: map[string]string{"owner": "ci"}}, true},
+		{SweepFilter{Tags: map[string]string{"temporary": ""}}, true},
+		{SweepFilter{Tags: map[string]string{"owner": "qa"}}, false},
+		{SweepFilter{Tags: map[string]string{"team": ""}}, false},
+		{SweepFilter{OlderThan: 24 * time.Hour}, true},
+		{SweepFilter{OlderThan: 72 * time.Hour}, false},
+		{SweepFilter{ResourceGroupId: "rg-abc"}, true},
+		{SweepFilter{ResourceGroupId: "rg-def"}, false},
+		{SweepFilter{Tags: map[string]string{"owner": "ci"}, OlderThan: 72 * time.Hour}, false},
+	}
+	for _, c := range cases {
+		matched, reason := c.filter.Match(candidate, now)
+		assert.Equal(t, c.expected, matched, "filter %+v: %s", c.filter, reason)
+	}
+	matched, _ := SweepFilter{OlderThan: time.Hour}.Match(SweepCandidate{Id: "vpc-def"}, now)
+	assert.False(t, matched, "the resources without the creation time should not be swept by age")
+}
+
+func TestUnitAliCloudSortedSweepers(t *testing.T) {
+	ordered, err := sortedSweepers([]string{"alicloud_vpc"})
+	assert.Nil(t, err)
+	index := make(map[string]int)
+	for i, sweeper := range ordered {
+		index[sweeper.Type] = i
+	}
+	assert.Equal(t, 4, len(ordered))
+	assert.Less(t, index["alicloud_instance"], index["alicloud_vswitch"])
+	assert.Less(t, index["alicloud_instance"], index["alicloud_security_group"])
+	assert.Less(t, index["alicloud_vswitch"], index["alicloud_vpc"])
+	assert.Less(t, index["alicloud_security_group"], index["alicloud_vpc"])
+
+	_, err = sortedSweepers([]string{"alicloud_unknown"})
+	assert.NotNil(t, err)
+
+	_, err = Sweep(nil, SweepOptions{DryRun: true})
+	assert.NotNil(t, err, "the empty filter should be rejected")
+}
+
+func TestUnitAliCloudSweepCandidateOf(t *testing.T) {
+	candidate := sweepCandidateOf(map[string]interface{}{
+		"InstanceId":      "i-abc",
+		"InstanceName":    "tf-testAcc-instance",
+		"ResourceGroupId": "rg-abc",
+		"CreationTime":    "2024-01-02T03:04Z",
+		"Tags": map[string]interface{}{
+			"Tag": []interface{}{map[string]interface{}{"TagKey": "owner", "TagValue": "ci"}},
+		},
+	}, "InstanceId", "InstanceName")
+	assert.Equal(t, "i-abc", candidate.Id)
+	assert.Equal(t, "tf-testAcc-instance", candidate.Name)
+	assert.Equal(t, "rg-abc", candidate.ResourceGroupId)
+	assert.Equal(t, map[string]string{"owner": "ci"}, candidate.Tags)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 0, 0, time.UTC), candidate.CreationTime)
+}
@@ -1,10 +1,14 @@
 package alicloud
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"reflect"
+	"strconv"
+	"strings"
 
-	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 
@@ -21,8 +25,8 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ots"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func String(v string) *string {
@@ -669,3 +673,278 @@ func ignoredTags(tagKey string, tagValue interface{}) bool {
 	}
 	return false
 }
+
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["default_tags_tags"],
+				},
+			},
+		},
+	}
+}
+
+// mergeDefaultTags returns the provider default tags merged with the resource tags.
+// The resource tags win on conflict.
+func mergeDefaultTags(defaultTags, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(defaultTags)+len(tags))
+	for key, value := range defaultTags {
+		result[key] = value
+	}
+	for key, value := range tags {
+		result[key] = value
+	}
+	return result
+}
+
+// withDefaultTags makes a resource which has an optional "tags" map aware of the provider default_tags.
+// The default tags are merged into "tags" before the resource create and update functions run, so that
+// every tagging path (ConvertTags, expandTagsToMap, setTags and the service SetResourceTags helpers) sends
+// the effective set. The computed "tags_all" exposes the effective set in the plan and detects the drift.
+func withDefaultTags(p *schema.Provider, r *schema.Resource) {
+	tags, ok := r.Schema["tags"]
+	if !ok || tags.Type != schema.TypeMap || !tags.Optional || tags.ForceNew || r.Update == nil {
+		return
+	}
+	if _, ok := r.Schema["tags_all"]; ok {
+		return
+	}
+	r.Schema["tags_all"] = &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+
+	diffSuppressFunc := tags.DiffSuppressFunc
+	tags.DiffSuppressFunc = func(k, old, new string, d *schema.ResourceData) bool {
+		if diffSuppressFunc != nil && diffSuppressFunc(k, old, new, d) {
+			return true
+		}
+		client, ok := p.Meta().(*connectivity.AliyunClient)
+		if !ok || client == nil {
+			return false
+		}
+		return defaultTagsDiffSuppressFunc(client.DefaultTags, k, old, new, d)
+	}
+
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		if customizeDiff != nil {
+			if err := customizeDiff(ctx, diff, meta); err != nil {
+				return err
+			}
+		}
+		if !diff.NewValueKnown("tags") {
+			return diff.SetNewComputed("tags_all")
+		}
+		client := meta.(*connectivity.AliyunClient)
+		tagsAll := mergeDefaultTags(client.DefaultTags, diff.Get("tags").(map[string]interface{}))
+		if !reflect.DeepEqual(tagsAll, diff.Get("tags_all").(map[string]interface{})) {
+			return diff.SetNew("tags_all", tagsAll)
+		}
+		return nil
+	}
+
+	create, read, update := r.Create, r.Read, r.Update
+	r.Create = func(d *schema.ResourceData, meta interface{}) error {
+		if err := setDefaultTags(d, meta); err != nil {
+			return err
+		}
+		if err := create(d, meta); err != nil {
+			return err
+		}
+		return d.Set("tags_all", d.Get("tags"))
+	}
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		if err := read(d, meta); err != nil {
+			return err
+		}
+		if d.Id() == "" {
+			return nil
+		}
+		return d.Set("tags_all", d.Get("tags"))
+	}
+	r.Update = func(d *schema.ResourceData, meta interface{}) error {
+		if err := setDefaultTags(d, meta); err != nil {
+			return err
+		}
+		if err := update(d, meta); err != nil {
+			return err
+		}
+		return d.Set("tags_all", d.Get("tags"))
+	}
+}
+
+// setDefaultTags merges the provider default tags into the "tags" of the resource data.
+func setDefaultTags(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AliyunClient)
+	if len(client.DefaultTags) == 0 {
+		return nil
+	}
+	tags := d.Get("tags").(map[string]interface{})
+	tagsAll := mergeDefaultTags(client.DefaultTags, tags)
+	if reflect.DeepEqual(tags, tagsAll) {
+		return nil
+	}
+	return d.Set("tags", tagsAll)
+}
+
+// defaultTagsDiffSuppressFunc suppresses the diff of the tags which are only set by the provider default_tags.
+func defaultTagsDiffSuppressFunc(defaultTags map[string]interface{}, k, old, new string, d *schema.ResourceData) bool {
+	if len(defaultTags) == 0 {
+		return false
+	}
+	key := strings.TrimPrefix(k, "tags.")
+	if key == "%" {
+		tags, _ := d.Get("tags").(map[string]interface{})
+		return old == strconv.Itoa(len(mergeDefaultTags(defaultTags, tags)))
+	}
+	if tags, _ := d.Get("tags").(map[string]interface{}); tags != nil {
+		if _, ok := tags[key]; ok {
+			return false
+		}
+	}
+	value, ok := defaultTags[key]
+	return ok && fmt.Sprint(value) == old
+}
+
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["ignore_tags_keys"],
+				},
+				"key_prefixes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["ignore_tags_key_prefixes"],
+				},
+			},
+		},
+	}
+}
+
+// withIgnoreTags removes the tags matching the provider ignore_tags from the "tags" and "tags_all" of a
+// resource, and from the nested "tags" of a data source, after they have been read back. It works on the
+// resource data rather than in tagsToMap and the service SetResourceTags helpers, so that every read path
+// is covered. The ignored tags are never in the state, so they are never planned for removal.
+func withIgnoreTags(r *schema.Resource, isDataSource bool) {
+	if r.Read == nil || !schemaHasTags(r.Schema) {
+		return
+	}
+	read := r.Read
+	r.Read = func(d *schema.ResourceData, meta interface{}) error {
+		if err := read(d, meta); err != nil {
+			return err
+		}
+		client, ok := meta.(*connectivity.AliyunClient)
+		if !ok || client.IgnoreTags == nil || d.Id() == "" {
+			return nil
+		}
+		for key, s := range r.Schema {
+			// The top level tags of a data source is a filter argument set by the user.
+			if isDataSource && !s.Computed {
+				continue
+			}
+			if !isTagsSchema(key, s) && !schemaHasTags(nestedSchema(s)) {
+				continue
+			}
+			object := map[string]interface{}{key: d.Get(key)}
+			if !removeIgnoredTags(map[string]*schema.Schema{key: s}, object, client.IgnoreTags) {
+				continue
+			}
+			if err := d.Set(key, object[key]); err != nil {
+				return WrapError(err)
+			}
+		}
+		return nil
+	}
+}
+
+func isTagsSchema(key string, s *schema.Schema) bool {
+	return (key == "tags" || key == "tags_all") && s.Type == schema.TypeMap
+}
+
+func nestedSchema(s *schema.Schema) map[string]*schema.Schema {
+	if s.Type != schema.TypeList && s.Type != schema.TypeSet {
+		return nil
+	}
+	if elem, ok := s.Elem.(*schema.Resource); ok {
+		return elem.Schema
+	}
+	return nil
+}
+
+func schemaHasTags(schemas map[string]*schema.Schema) bool {
+	for key, s := range schemas {
+		if isTagsSchema(key, s) || schemaHasTags(nestedSchema(s)) {
+			return true
+		}
+	}
+	return false
+}
+
+// removeIgnoredTags removes the ignored tags from the tags maps in the object, walking down the nested
+// blocks described by the schemas. The sets are converted to lists so that they can be set back.
+// It returns whether any tag has been removed.
+func removeIgnoredTags(schemas map[string]*schema.Schema, object map[string]interface{}, ignoreTags *connectivity.IgnoreTags) bool {
+	removed := false
+	for key, s := range schemas {
+		value, ok := object[key]
+		if !ok || value == nil {
+			continue
+		}
+		if isTagsSchema(key, s) {
+			tags, ok := value.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			for tagKey := range tags {
+				if ignoreTags.Ignored(tagKey) {
+					log.Printf("[DEBUG] Found tag %s matching the provider ignore_tags, ignoring.", tagKey)
+					delete(tags, tagKey)
+					removed = true
+				}
+			}
+			continue
+		}
+		nested := nestedSchema(s)
+		if !schemaHasTags(nested) {
+			continue
+		}
+		var items []interface{}
+		switch v := value.(type) {
+		case []interface{}:
+			items = v
+		case []map[string]interface{}:
+			for _, item := range v {
+				items = append(items, item)
+			}
+		case *schema.Set:
+			items = v.List()
+		}
+		for _, item := range items {
+			if itemMap, ok := item.(map[string]interface{}); ok && removeIgnoredTags(nested, itemMap, ignoreTags) {
+				removed = true
+			}
+		}
+		object[key] = items
+	}
+	return removed
+}
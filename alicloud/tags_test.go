@@ -1,7 +1,11 @@
 package alicloud
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestTagsMapEqual(t *testing.T) {
@@ -48,3 +52,116 @@ func TestTagsMapEqual(t *testing.T) {
 		t.Fatal("Tag maps is equal.")
 	}
 }
+
+func TestMergeDefaultTags(t *testing.T) {
+	defaultTags := map[string]interface{}{
+		"CostCenter": "default",
+		"Owner":      "platform",
+	}
+	tags := map[string]interface{}{
+		"CostCenter": "resource",
+		"For":        "acceptance test",
+	}
+	expected := map[string]interface{}{
+		"CostCenter": "resource",
+		"Owner":      "platform",
+		"For":        "acceptance test",
+	}
+	if got := mergeDefaultTags(defaultTags, tags); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected merged tags %v, got %v.", expected, got)
+	}
+	if got := mergeDefaultTags(nil, tags); !reflect.DeepEqual(got, tags) {
+		t.Fatalf("expected merged tags %v, got %v.", tags, got)
+	}
+}
+
+func TestDefaultTagsDiffSuppressFunc(t *testing.T) {
+	defaultTags := map[string]interface{}{
+		"Owner": "platform",
+	}
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{"tags": tagsSchema()}, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"For": "acceptance test",
+		},
+	})
+	if !defaultTagsDiffSuppressFunc(defaultTags, "tags.Owner", "platform", "", d) {
+		t.Fatal("the diff of the default tag should be suppressed.")
+	}
+	if defaultTagsDiffSuppressFunc(defaultTags, "tags.Owner", "changed", "", d) {
+		t.Fatal("the diff of the changed default tag should not be suppressed.")
+	}
+	if !defaultTagsDiffSuppressFunc(defaultTags, "tags.%", "2", "1", d) {
+		t.Fatal("the diff of the tags count should be suppressed.")
+	}
+	if defaultTagsDiffSuppressFunc(nil, "tags.Owner", "platform", "", d) {
+		t.Fatal("the diff should not be suppressed without default tags.")
+	}
+}
+
+func TestWithDefaultTags(t *testing.T) {
+	p := Provider()
+	for name, r := range p.ResourcesMap {
+		tags, ok := r.Schema["tags"]
+		if !ok || tags.Type != schema.TypeMap || !tags.Optional || tags.ForceNew || r.Update == nil {
+			continue
+		}
+		if v, ok := r.Schema["tags_all"]; !ok || !v.Computed {
+			t.Fatalf("resource %s should have the computed tags_all.", name)
+		}
+		if r.CustomizeDiff == nil {
+			t.Fatalf("resource %s should have the CustomizeDiff.", name)
+		}
+	}
+}
+
+func TestRemoveIgnoredTags(t *testing.T) {
+	ignoreTags := &connectivity.IgnoreTags{
+		Keys:        []string{"ack.aliyun.com"},
+		KeyPrefixes: []string{"acs:"},
+	}
+	schemas := map[string]*schema.Schema{
+		"tags": tagsSchema(),
+		"instances": {
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id":   {Type: schema.TypeString, Computed: true},
+					"tags": tagsSchemaComputed(),
+				},
+			},
+		},
+	}
+	object := map[string]interface{}{
+		"tags": map[string]interface{}{
+			"ack.aliyun.com": "c-xxx",
+			"For":            "acceptance test",
+		},
+		"instances": []map[string]interface{}{
+			{
+				"id": "i-xxx",
+				"tags": map[string]interface{}{
+					"acs:ecs:owner": "ack",
+					"For":           "acceptance test",
+				},
+			},
+		},
+	}
+	if !removeIgnoredTags(schemas, object, ignoreTags) {
+		t.Fatal("the ignored tags should be removed.")
+	}
+	expected := map[string]interface{}{"For": "acceptance test"}
+	if !reflect.DeepEqual(object["tags"], expected) {
+		t.Fatalf("expected tags %v, got %v.", expected, object["tags"])
+	}
+	instance := object["instances"].([]interface{})[0].(map[string]interface{})
+	if !reflect.DeepEqual(instance["tags"], expected) {
+		t.Fatalf("expected nested tags %v, got %v.", expected, instance["tags"])
+	}
+	if removeIgnoredTags(schemas, object, ignoreTags) {
+		t.Fatal("no tag should be removed twice.")
+	}
+	if (*connectivity.IgnoreTags)(nil).Ignored("acs:ecs:owner") {
+		t.Fatal("no tag should be ignored without ignore_tags.")
+	}
+}
@@ -1,11 +1,156 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"strings"
+
 	"github.com/aliyun/terraform-provider-alicloud/alicloud"
-	"github.com/hashicorp/terraform-plugin-sdk/plugin"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/connectivity"
+	"github.com/aliyun/terraform-provider-alicloud/alicloud/generate"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func main() {
-	plugin.Serve(&plugin.ServeOpts{
-		ProviderFunc: alicloud.Provider})
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		runGenerate(os.Args[2:])
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "sweep" {
+		runSweep(os.Args[2:])
+		return
+	}
+
+	var debug bool
+	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.Parse()
+
+	serverFactory, err := alicloud.ProtoV6ProviderServerFactory(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var serveOpts []tf6server.ServeOpt
+	if debug {
+		serveOpts = append(serveOpts, tf6server.WithManagedDebug())
+	}
+	if err := tf6server.Serve("registry.terraform.io/aliyun/alicloud", serverFactory, serveOpts...); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// runGenerate writes the import blocks and the skeleton configurations of the existing resources, like:
+//
+//	terraform-provider-alicloud generate -regions cn-hangzhou,cn-beijing -types alicloud_vpc -state terraform.tfstate -out imports.tf
+func runGenerate(args []string) {
+	flags := flag.NewFlagSet("generate", flag.ExitOnError)
+	regions := flags.String("regions", os.Getenv("ALICLOUD_REGION"), "comma separated regions to enumerate the resources in, default to the ALICLOUD_REGION environment variable")
+	types := flags.String("types", "", "comma separated resource types to generate, default to all of the supported types")
+	stateFile := flags.String("state", "", "the state file whose resources are skipped")
+	out := flags.String("out", "", "the file to write the configurations into, default to the standard output")
+	flags.Parse(args)
+
+	opts := generate.Options{
+		Regions:   splitList(*regions),
+		Types:     splitList(*types),
+		StateFile: *stateFile,
+	}
+	w := os.Stdout
+	if *out != "" {
+		file, err := os.Create(*out)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer file.Close()
+		w = file
+	}
+	if err := generate.Generate(context.Background(), w, opts); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// runSweep deletes the resources matching the filters, and writes the json report of them, like:
+//
+//	terraform-provider-alicloud sweep -regions cn-hangzhou -tags owner=ci -older-than 24h -dry-run=false -report report.json
+func runSweep(args []string) {
+	flags := flag.NewFlagSet("sweep", flag.ExitOnError)
+	regions := flags.String("regions", os.Getenv("ALICLOUD_REGION"), "comma separated regions to sweep, default to the ALICLOUD_REGION environment variable")
+	types := flags.String("types", "", "comma separated resource types to sweep, default to all of the supported types")
+	namePrefixes := flags.String("name-prefixes", "", "comma separated prefixes of the names of the resources to sweep")
+	tags := flags.String("tags", "", "comma separated tags of the resources to sweep, like owner=ci,temporary")
+	olderThan := flags.Duration("older-than", 0, "the minimum age of the resources to sweep, like 24h")
+	resourceGroupId := flags.String("resource-group-id", "", "the resource group of the resources to sweep")
+	dryRun := flags.Bool("dry-run", true, "only report the resources which would be deleted")
+	report := flags.String("report", "", "the file to write the json report into, default to the standard output")
+	flags.Parse(args)
+
+	opts := alicloud.SweepOptions{
+		Types: splitList(*types),
+		Filter: alicloud.SweepFilter{
+			NamePrefixes:    splitList(*namePrefixes),
+			Tags:            make(map[string]string),
+			OlderThan:       *olderThan,
+			ResourceGroupId: *resourceGroupId,
+		},
+		DryRun: *dryRun,
+	}
+	for _, tag := range splitList(*tags) {
+		parts := strings.SplitN(tag, "=", 2)
+		if len(parts) == 1 {
+			parts = append(parts, "")
+		}
+		opts.Filter.Tags[parts[0]] = parts[1]
+	}
+
+	result := &alicloud.SweepReport{DryRun: *dryRun, Items: []alicloud.SweepReportItem{}}
+	var sweepErr error
+	for _, region := range splitList(*regions) {
+		p := alicloud.Provider()
+		if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{"region": region})); diags.HasError() {
+			log.Fatalf("configuring the provider in the region %s got an error: %v", region, diags)
+		}
+		r, err := alicloud.Sweep(p.Meta().(*connectivity.AliyunClient), opts)
+		if r != nil {
+			result.Items = append(result.Items, r.Items...)
+		}
+		if err != nil {
+			sweepErr = err
+			break
+		}
+	}
+
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *report != "" {
+		err = os.WriteFile(*report, data, 0644)
+	} else {
+		_, err = os.Stdout.Write(append(data, '\n'))
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	if sweepErr != nil {
+		log.Fatal(sweepErr)
+	}
+	for _, item := range result.Items {
+		if item.Status == alicloud.SweepStatusFailed {
+			os.Exit(1)
+		}
+	}
+}
+
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
 }
@@ -13,7 +13,7 @@ import (
 
 	"github.com/aliyun/terraform-provider-alicloud/alicloud"
 	mapset "github.com/deckarep/golang-set"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	log "github.com/sirupsen/logrus"
 	"github.com/waigani/diffparser"
 )
@@ -79,14 +79,14 @@ func main() {
 		var resource = &schema.Resource{}
 		var ok bool
 		if isResource {
-			resource, ok = alicloud.Provider().(*schema.Provider).ResourcesMap[resourceName]
+			resource, ok = alicloud.Provider().ResourcesMap[resourceName]
 			if !ok || resource == nil {
 				log.Errorf("resource %s is not found in the provider ResourceMap\n\n", resourceName)
 				exitCode = 1
 				continue
 			}
 		} else {
-			resource, ok = alicloud.Provider().(*schema.Provider).DataSourcesMap[resourceName]
+			resource, ok = alicloud.Provider().DataSourcesMap[resourceName]
 			if !ok || resource == nil {
 				log.Errorf("data source %s is not found in the provider DataSourcesMap\n\n", resourceName)
 				exitCode = 1
@@ -157,7 +157,7 @@ func getSchemaAttr(isResource bool, schema map[string]*schema.Schema,
 func getSchemaAttributes(rootName string, schemaAttributes map[string]SchemaAttribute,
 	resourceSchema map[string]*schema.Schema) {
 	for key, value := range resourceSchema {
-		if len(value.Removed) != 0 {
+		if strings.Contains(value.Deprecated, "removed") {
 			continue
 		}
 